@@ -3,12 +3,14 @@ package main
 import (
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 type Bible map[string]map[string]map[string]string
@@ -28,17 +30,52 @@ type BibleData struct {
 }
 
 type MultiBibleData struct {
+	mu               sync.Mutex
 	translations     map[string]*BibleData
 	translationNames []string
-	filePaths        map[string]string
+	translationInfo  map[string]TranslationInfo
+	loadErrors       map[string]error
+	versifications   map[string]*versification
+	source           TranslationSource
 }
 
 func NewBibleData(jsonData []byte) (*BibleData, error) {
 	var bible Bible
 	if err := json.Unmarshal(jsonData, &bible); err != nil {
+		if syntaxErr := jsonSyntaxError(jsonData, err); syntaxErr != err {
+			return nil, syntaxErr
+		}
 		return nil, fmt.Errorf("failed to parse bible JSON: %w", err)
 	}
 
+	if err := checkBibleStructure(bible); err != nil {
+		return nil, err
+	}
+
+	return newBibleDataFromBible(bible), nil
+}
+
+// checkBibleStructure rejects books without verses and chapter keys that
+// are not numbers, which would otherwise be dropped without a trace.
+func checkBibleStructure(bible Bible) error {
+	for _, book := range sortedKeys(bible) {
+		verseCount := 0
+		for _, key := range sortedKeys(bible[book]) {
+			if _, err := strconv.Atoi(key); err != nil {
+				return &ChapterKeyError{Book: book, Key: key}
+			}
+			verseCount += len(bible[book][key])
+		}
+		if verseCount == 0 {
+			return &EmptyBookError{Book: book}
+		}
+	}
+	return nil
+}
+
+// newBibleDataFromBible builds the verse list and indexes shared by all
+// translation formats once they have been decoded into a Bible.
+func newBibleDataFromBible(bible Bible) *BibleData {
 	bd := &BibleData{
 		verses:       make([]Verse, 0),
 		bookList:     make([]string, 0, len(bible)),
@@ -92,7 +129,7 @@ func NewBibleData(jsonData []byte) (*BibleData, error) {
 		}
 	}
 
-	return bd, nil
+	return bd
 }
 
 var biblicalOrder = []string{
@@ -146,37 +183,56 @@ func ensureConfigDir() (string, error) {
 	return dir, nil
 }
 
-func NewMultiBibleData() (*MultiBibleData, error) {
-	mbd := &MultiBibleData{
-		translations:     make(map[string]*BibleData),
-		translationNames: []string{},
-		filePaths:        make(map[string]string),
+func getTranslationsDir() (string, error) {
+	configDir, err := getConfigDir()
+	if err != nil {
+		return "", err
 	}
+	return filepath.Join(configDir, "translations"), nil
+}
 
-	configDir, err := getConfigDir()
+func NewMultiBibleData() (*MultiBibleData, error) {
+	translationsDir, err := getTranslationsDir()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get config dir: %w", err)
 	}
-	translationsDir := filepath.Join(configDir, "translations")
-	files, err := filepath.Glob(filepath.Join(translationsDir, "*_bible.json"))
+
+	mbd, err := NewMultiBibleDataFromSource(NewDirectorySource(translationsDir))
+	if errors.Is(err, errNoTranslations) {
+		return nil, fmt.Errorf("%w in %s (expected files like ESV_bible.json, KJV.osis.xml, WEB.tsv or a directory of USFM books)", err, translationsDir)
+	}
+	return mbd, err
+}
+
+var errNoTranslations = errors.New("no bible translations found")
+
+// NewMultiBibleDataFromSource lists the translations offered by source.
+// Translations are opened lazily the first time they are requested.
+func NewMultiBibleDataFromSource(source TranslationSource) (*MultiBibleData, error) {
+	infos, err := source.List()
 	if err != nil {
-		return nil, fmt.Errorf("failed to glob bible files: %w", err)
+		return nil, fmt.Errorf("failed to list translations: %w", err)
 	}
 
-	if len(files) == 0 {
-		return nil, fmt.Errorf("no bible JSON files found in %s (expected files like ESV_bible.json)", translationsDir)
+	mbd := &MultiBibleData{
+		translations:     make(map[string]*BibleData),
+		translationNames: []string{},
+		translationInfo:  make(map[string]TranslationInfo),
+		loadErrors:       make(map[string]error),
+		versifications:   make(map[string]*versification),
+		source:           source,
 	}
 
-	for _, file := range files {
-		if strings.HasSuffix(file, "_bible.json") {
-			transName := strings.TrimSuffix(filepath.Base(file), "_bible.json")
-			mbd.filePaths[transName] = file
-			mbd.translationNames = append(mbd.translationNames, transName)
+	for _, info := range infos {
+		if _, exists := mbd.translationInfo[info.Name]; exists {
+			continue
 		}
+		mbd.translationInfo[info.Name] = info
+		mbd.translationNames = append(mbd.translationNames, info.Name)
 	}
 
 	if len(mbd.translationNames) == 0 {
-		return nil, fmt.Errorf("no valid bible translation files found")
+		return nil, errNoTranslations
 	}
 
 	sort.Strings(mbd.translationNames)
@@ -185,32 +241,72 @@ func NewMultiBibleData() (*MultiBibleData, error) {
 }
 
 func (mbd *MultiBibleData) GetCurrentBibleData(translation string) *BibleData {
-	if bd, exists := mbd.translations[translation]; exists {
+	if bd, err := mbd.LoadTranslation(translation); err == nil {
 		return bd
 	}
-
-	if filePath, exists := mbd.filePaths[translation]; exists {
-		if bd := mbd.loadTranslation(filePath); bd != nil {
-			mbd.translations[translation] = bd
-			return bd
-		}
-	}
-
 	return mbd.getFallbackTranslation(translation)
 }
 
-func (mbd *MultiBibleData) loadTranslation(filePath string) *BibleData {
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		return nil
+// IsLoaded reports whether translation can be returned without parsing.
+func (mbd *MultiBibleData) IsLoaded(translation string) bool {
+	mbd.mu.Lock()
+	defer mbd.mu.Unlock()
+	_, exists := mbd.translations[translation]
+	return exists
+}
+
+// LoadTranslation returns translation, opening it from the source on
+// first use. It is safe to call from background commands; the slow part
+// runs without holding the lock.
+func (mbd *MultiBibleData) LoadTranslation(translation string) (*BibleData, error) {
+	mbd.mu.Lock()
+	bd, exists := mbd.translations[translation]
+	mbd.mu.Unlock()
+	if exists {
+		return bd, nil
 	}
 
-	bd, err := NewBibleData(data)
+	info, exists := mbd.translationInfo[translation]
+	if !exists {
+		return nil, fmt.Errorf("unknown translation %q", translation)
+	}
+
+	bd, err := mbd.source.Open(translation)
+
+	mbd.mu.Lock()
+	defer mbd.mu.Unlock()
 	if err != nil {
-		return nil
+		loadErr := &TranslationLoadError{Translation: translation, Path: info.Location, Err: err}
+		mbd.loadErrors[translation] = loadErr
+		return nil, loadErr
 	}
+	delete(mbd.loadErrors, translation)
+	if existing, exists := mbd.translations[translation]; exists {
+		return existing, nil
+	}
+	mbd.translations[translation] = bd
+	return bd, nil
+}
 
-	return bd
+// LoadError returns the error from the last failed attempt to load
+// translation, or nil if it loaded or was never tried.
+func (mbd *MultiBibleData) LoadError(translation string) error {
+	mbd.mu.Lock()
+	defer mbd.mu.Unlock()
+	return mbd.loadErrors[translation]
+}
+
+// LoadErrors returns every recorded load failure in translation order.
+func (mbd *MultiBibleData) LoadErrors() []error {
+	mbd.mu.Lock()
+	defer mbd.mu.Unlock()
+	var errs []error
+	for _, name := range mbd.translationNames {
+		if err, exists := mbd.loadErrors[name]; exists {
+			errs = append(errs, err)
+		}
+	}
+	return errs
 }
 
 func (mbd *MultiBibleData) getFallbackTranslation(translation string) *BibleData {
@@ -301,17 +397,6 @@ func sortAndExtractVerses(matches []scoredVerse) []Verse {
 	return verses
 }
 
-func (bd *BibleData) findBook(bookName string) string {
-	bookNameLower := strings.ToLower(bookName)
-	for _, book := range bd.bookList {
-		bookLower := strings.ToLower(book)
-		if bookLower == bookNameLower || strings.HasPrefix(bookLower, bookNameLower) {
-			return book
-		}
-	}
-	return ""
-}
-
 func (bd *BibleData) Search(query string) []Verse {
 	if query == "" {
 		return []Verse{}
@@ -395,63 +480,3 @@ func (bd *BibleData) fullTextSearch(query string) []Verse {
 	}
 	return sortAndExtractVerses(matches)
 }
-
-func (bd *BibleData) searchByReference(query string) []Verse {
-	query = strings.TrimSpace(query)
-
-	parts := strings.Split(query, ":")
-	var bookChapter string
-	var verseNum int
-
-	if len(parts) == 2 {
-		bookChapter = strings.TrimSpace(parts[0])
-		if num, err := strconv.Atoi(strings.TrimSpace(parts[1])); err == nil {
-			verseNum = num
-		}
-	} else {
-		bookChapter = query
-		verseNum = -1
-	}
-
-	words := strings.Fields(bookChapter)
-	if len(words) == 0 {
-		return nil
-	}
-
-	var bookName string
-	var chapterNum int
-
-	lastWord := words[len(words)-1]
-	if num, err := strconv.Atoi(lastWord); err == nil && num > 0 {
-		chapterNum = num
-		bookName = strings.Join(words[:len(words)-1], " ")
-	} else {
-		bookName = strings.Join(words, " ")
-		chapterNum = -1
-	}
-
-	if bookName == "" {
-		return nil
-	}
-
-	matchedBook := bd.findBook(bookName)
-	if matchedBook == "" {
-		return nil
-	}
-
-	var results []Verse
-
-	for _, verse := range bd.verses {
-		if verse.Book == matchedBook {
-			if chapterNum > 0 && verse.Chapter != chapterNum {
-				continue
-			}
-			if verseNum > 0 && verse.Verse != verseNum {
-				continue
-			}
-			results = append(results, verse)
-		}
-	}
-
-	return results
-}
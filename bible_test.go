@@ -0,0 +1,130 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckBibleStructure(t *testing.T) {
+	tests := []struct {
+		name  string
+		bible Bible
+		want  error
+	}{
+		{name: "valid", bible: Bible{"Genesis": {"1": {"1": "In the beginning"}}}},
+		{name: "empty translation", bible: Bible{}},
+		{name: "empty chapter in a book with verses", bible: Bible{"Genesis": {"1": {"1": "In the beginning"}, "2": {}}}},
+		{name: "book without chapters", bible: Bible{"Genesis": {"1": {"1": "In the beginning"}}, "Exodus": {}}, want: &EmptyBookError{Book: "Exodus"}},
+		{name: "book with empty chapters", bible: Bible{"Exodus": {"1": {}, "2": {}}}, want: &EmptyBookError{Book: "Exodus"}},
+		{name: "chapter key", bible: Bible{"Genesis": {"1": {"1": "In the beginning"}, "one": {"1": "x"}}}, want: &ChapterKeyError{Book: "Genesis", Key: "one"}},
+		{name: "first problem in book order", bible: Bible{"Exodus": {}, "Genesis": {"x": {}}}, want: &EmptyBookError{Book: "Exodus"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkBibleStructure(tt.bible)
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("checkBibleStructure() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.want.Error() {
+				t.Errorf("checkBibleStructure() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewBibleDataErrors(t *testing.T) {
+	var emptyBook *EmptyBookError
+	var chapterKey *ChapterKeyError
+	var syntax *SyntaxError
+	tests := []struct {
+		name    string
+		json    string
+		target  any
+		wantErr string
+	}{
+		{"empty book", `{"Genesis": {}}`, &emptyBook, `book "Genesis" has no verses`},
+		{"chapter key", `{"Genesis": {"1:1": {"1": "x"}}}`, &chapterKey, `book "Genesis" has non-numeric chapter key "1:1"`},
+		{"trailing comma", "{\"Genesis\": {\"1\": {\n  \"1\": \"x\",\n}}}", &syntax, "JSON syntax error at line 3, column 1: invalid character '}' looking for beginning of object key string"},
+		{"wrong type", "{\n\"Genesis\": []}", &syntax, "JSON syntax error at line 2, column 12: json: cannot unmarshal array into Go struct field Bible.Genesis of type map[string]map[string]string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBibleData([]byte(tt.json))
+			if !errors.As(err, tt.target) {
+				t.Fatalf("NewBibleData() error = %v (%T), want %T", err, err, tt.target)
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("NewBibleData() error = %q, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTranslationLoadError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "KJV_bible.json")
+	_, readErr := loadTranslationFile(path)
+	if !errors.Is(readErr, fs.ErrNotExist) {
+		t.Fatalf("loadTranslationFile() error = %v, want %v", readErr, fs.ErrNotExist)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"Genesis": {}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, emptyErr := loadTranslationFile(path)
+
+	tests := []struct {
+		name    string
+		err     *TranslationLoadError
+		want    string
+		wantAs  func(error) bool
+		wantErr error
+	}{
+		{
+			name: "without a path",
+			err:  &TranslationLoadError{Translation: "KJV", Err: emptyErr},
+			want: `translation KJV: book "Genesis" has no verses`,
+			wantAs: func(err error) bool {
+				var target *EmptyBookError
+				return errors.As(err, &target) && target.Book == "Genesis"
+			},
+		},
+		{
+			name: "with a path",
+			err:  &TranslationLoadError{Translation: "KJV", Path: path, Err: emptyErr},
+			want: "translation KJV (" + path + `): book "Genesis" has no verses`,
+			wantAs: func(err error) bool {
+				var target *EmptyBookError
+				return errors.As(err, &target)
+			},
+		},
+		{
+			name: "unreadable",
+			err:  &TranslationLoadError{Translation: "KJV", Path: path, Err: readErr},
+			want: "translation KJV (" + path + "): " + readErr.Error(),
+			wantAs: func(err error) bool {
+				var target *ReadError
+				return errors.As(err, &target) && target.Path == path
+			},
+			wantErr: fs.ErrNotExist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+			if !tt.wantAs(tt.err) {
+				t.Errorf("errors.As did not find the wrapped error in %v", tt.err)
+			}
+			if tt.wantErr != nil && !errors.Is(tt.err, tt.wantErr) {
+				t.Errorf("errors.Is(%v, %v) = false", tt.err, tt.wantErr)
+			}
+		})
+	}
+}
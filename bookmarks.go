@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Bookmark is a saved verse, or a range of verses up to EndVerse, in the
+// translation it was read in. Text is kept for the preview so the
+// bookmarks screen does not have to load every translation.
+type Bookmark struct {
+	Translation string    `json:"translation"`
+	Book        string    `json:"book"`
+	Chapter     int       `json:"chapter"`
+	Verse       int       `json:"verse"`
+	EndVerse    int       `json:"endVerse,omitempty"`
+	Text        string    `json:"text"`
+	Created     time.Time `json:"created"`
+}
+
+func (b Bookmark) key() verseKey {
+	return verseKey{book: b.Book, chapter: b.Chapter, verse: b.Verse}
+}
+
+func (b Bookmark) reference() string {
+	if b.EndVerse > b.Verse {
+		return fmt.Sprintf("%s %s %d:%d-%d", b.Translation, b.Book, b.Chapter, b.Verse, b.EndVerse)
+	}
+	return fmt.Sprintf("%s %s %d:%d", b.Translation, b.Book, b.Chapter, b.Verse)
+}
+
+func saveBookmarks(bookmarks []Bookmark) error {
+	return saveJSON(bookmarksFile, bookmarks)
+}
+
+func loadBookmarks() []Bookmark {
+	var bookmarks []Bookmark
+	if err := loadJSON(bookmarksFile, &bookmarks); err != nil {
+		return nil
+	}
+	return bookmarks
+}
+
+func (m model) bookmarkIndex(translation string, ref verseKey, endVerse int) int {
+	for i, bookmark := range m.bookmarks {
+		if bookmark.Translation == translation && bookmark.key() == ref && bookmark.EndVerse == endVerse {
+			return i
+		}
+	}
+	return -1
+}
+
+// toggleBookmark adds a bookmark on verses, which must be consecutive
+// verses of one chapter, or removes the one that is already there.
+func (m *model) toggleBookmark(verses []Verse) {
+	if len(verses) == 0 {
+		return
+	}
+	first := verses[0]
+	ref := verseKey{book: first.Book, chapter: first.Chapter, verse: first.Verse}
+	endVerse := 0
+	if len(verses) > 1 {
+		endVerse = verses[len(verses)-1].Verse
+	}
+
+	if i := m.bookmarkIndex(m.currentTranslation, ref, endVerse); i >= 0 {
+		m.bookmarks = append(m.bookmarks[:i:i], m.bookmarks[i+1:]...)
+		m.statusMessage = fmt.Sprintf("Removed bookmark %s", formatPassage(verses))
+	} else {
+		texts := make([]string, len(verses))
+		for i, verse := range verses {
+			texts[i] = verse.Text
+		}
+		m.bookmarks = append(m.bookmarks, Bookmark{
+			Translation: m.currentTranslation,
+			Book:        first.Book,
+			Chapter:     first.Chapter,
+			Verse:       first.Verse,
+			EndVerse:    endVerse,
+			Text:        strings.Join(texts, " "),
+			Created:     time.Now(),
+		})
+		m.statusMessage = fmt.Sprintf("Bookmarked %s", formatPassage(verses))
+	}
+
+	if err := saveBookmarks(m.bookmarks); err != nil {
+		m.statusMessage = fmt.Sprintf("Failed to save bookmarks: %v", err)
+	}
+}
+
+// openBookmarks switches to the bookmarks screen, remembering the reading
+// position so leaving the screen returns to it.
+func (m *model) openBookmarks() {
+	m.savedSelected = m.selected
+	m.savedScrollOffset = m.scrollOffset
+	m.mode = bookmarksMode
+	m.selected = 0
+	m.scrollOffset = 0
+}
+
+func (m *model) closeBookmarks() {
+	m.mode = navigationMode
+	m.selected = m.savedSelected
+	m.scrollOffset = m.savedScrollOffset
+}
+
+func (m *model) deleteSelectedBookmark() {
+	if m.selected >= len(m.bookmarks) {
+		return
+	}
+	m.bookmarks = append(m.bookmarks[:m.selected:m.selected], m.bookmarks[m.selected+1:]...)
+	m.clampSelectedIndex(len(m.bookmarks))
+	if err := saveBookmarks(m.bookmarks); err != nil {
+		m.statusMessage = fmt.Sprintf("Failed to save bookmarks: %v", err)
+	}
+}
+
+func (m *model) openSelectedBookmark() tea.Cmd {
+	if m.selected >= len(m.bookmarks) {
+		return nil
+	}
+	bookmark := m.bookmarks[m.selected]
+	m.closeBookmarks()
+	m.recordJump()
+	return m.jumpTo(bookmark.Translation, bookmark.key())
+}
+
+// renderBookmarks renders the bookmarks screen below the header and
+// returns the number of lines used.
+func (m *model) renderBookmarks(content *strings.Builder) int {
+	if len(m.bookmarks) == 0 {
+		content.WriteString(m.centerText("No bookmarks yet. Press B on a verse to add one."))
+		content.WriteByte('\n')
+		return 1
+	}
+
+	items := make([]Verse, len(m.bookmarks))
+	for i, bookmark := range m.bookmarks {
+		items[i] = Verse{Book: bookmark.Book, Chapter: bookmark.Chapter, Verse: bookmark.Verse, Text: bookmark.Text}
+	}
+	return m.renderVerseList(content, items, func(i int) string {
+		return m.bookmarks[i].reference()
+	})
+}
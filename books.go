@@ -0,0 +1,237 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var errUnknownBook = errors.New("unknown book")
+
+type ambiguousBookError struct {
+	name       string
+	candidates []string
+}
+
+func (e *ambiguousBookError) Error() string {
+	return fmt.Sprintf("ambiguous book %q (could be %s)", e.name, strings.Join(e.candidates, ", "))
+}
+
+// bookAliasTable lists the accepted abbreviations and alternative names
+// for every book in biblicalOrder, covering SBL and OSIS abbreviations.
+// Aliases of numbered books are written without the number; it is added
+// when the table is expanded.
+var bookAliasTable = map[string][]string{
+	"Genesis":         {"Gen", "Ge", "Gn"},
+	"Exodus":          {"Exod", "Exo", "Ex"},
+	"Leviticus":       {"Lev", "Le", "Lv"},
+	"Numbers":         {"Num", "Nu", "Nm", "Nb"},
+	"Deuteronomy":     {"Deut", "Deu", "Dt", "De"},
+	"Joshua":          {"Josh", "Jos", "Jsh"},
+	"Judges":          {"Judg", "Jdg", "Jg", "Jdgs"},
+	"Ruth":            {"Rth", "Ru"},
+	"1 Samuel":        {"Samuel", "Sam", "Sa", "Sm"},
+	"2 Samuel":        {"Samuel", "Sam", "Sa", "Sm"},
+	"1 Kings":         {"Kings", "Kgs", "Ki", "Kg", "Kin"},
+	"2 Kings":         {"Kings", "Kgs", "Ki", "Kg", "Kin"},
+	"1 Chronicles":    {"Chronicles", "Chron", "Chr", "Ch"},
+	"2 Chronicles":    {"Chronicles", "Chron", "Chr", "Ch"},
+	"Ezra":            {"Ezr"},
+	"Nehemiah":        {"Neh", "Ne"},
+	"Esther":          {"Esth", "Est", "Es"},
+	"Job":             {"Jb"},
+	"Psalm":           {"Psalms", "Ps", "Psa", "Pss", "Psm", "Pslm"},
+	"Proverbs":        {"Prov", "Pro", "Prv", "Pr"},
+	"Ecclesiastes":    {"Eccl", "Eccles", "Ecc", "Ec", "Qoh", "Qoheleth"},
+	"Song Of Solomon": {"Song", "Song of Songs", "Canticles", "Canticle of Canticles", "Cant", "Sng", "SOS", "So"},
+	"Isaiah":          {"Isa", "Is"},
+	"Jeremiah":        {"Jer", "Je", "Jr"},
+	"Lamentations":    {"Lam", "La"},
+	"Ezekiel":         {"Ezek", "Eze", "Ezk"},
+	"Daniel":          {"Dan", "Da", "Dn"},
+	"Hosea":           {"Hos", "Ho"},
+	"Joel":            {"Jl"},
+	"Amos":            {"Am"},
+	"Obadiah":         {"Obad", "Oba", "Ob"},
+	"Jonah":           {"Jon", "Jnh"},
+	"Micah":           {"Mic", "Mc"},
+	"Nahum":           {"Nah", "Na"},
+	"Habakkuk":        {"Hab", "Hb"},
+	"Zephaniah":       {"Zeph", "Zep", "Zp"},
+	"Haggai":          {"Hag", "Hg"},
+	"Zechariah":       {"Zech", "Zec", "Zc"},
+	"Malachi":         {"Mal", "Ml"},
+	"Matthew":         {"Matt", "Mat", "Mt"},
+	"Mark":            {"Mrk", "Mar", "Mk"},
+	"Luke":            {"Luk", "Lk"},
+	"John":            {"Jhn", "Joh", "Jn"},
+	"Acts":            {"Act", "Ac"},
+	"Romans":          {"Rom", "Ro", "Rm"},
+	"1 Corinthians":   {"Corinthians", "Cor", "Co"},
+	"2 Corinthians":   {"Corinthians", "Cor", "Co"},
+	"Galatians":       {"Gal", "Ga"},
+	"Ephesians":       {"Eph", "Ephes"},
+	"Philippians":     {"Phil", "Php", "Pp"},
+	"Colossians":      {"Col"},
+	"1 Thessalonians": {"Thessalonians", "Thess", "Thes", "Th"},
+	"2 Thessalonians": {"Thessalonians", "Thess", "Thes", "Th"},
+	"1 Timothy":       {"Timothy", "Tim", "Ti", "Tm"},
+	"2 Timothy":       {"Timothy", "Tim", "Ti", "Tm"},
+	"Titus":           {"Tit"},
+	"Philemon":        {"Phlm", "Philem", "Phm"},
+	"Hebrews":         {"Heb"},
+	"James":           {"Jas", "Jam", "Jm"},
+	"1 Peter":         {"Peter", "Pet", "Pe", "Pt"},
+	"2 Peter":         {"Peter", "Pet", "Pe", "Pt"},
+	"1 John":          {"John", "Jhn", "Joh", "Jn", "Jo"},
+	"2 John":          {"John", "Jhn", "Joh", "Jn", "Jo"},
+	"3 John":          {"John", "Jhn", "Joh", "Jn", "Jo"},
+	"Jude":            {"Jd"},
+	"Revelation":      {"Revelations", "Revelation of John", "Rev", "Re", "Rv", "Apocalypse", "Apoc"},
+}
+
+// bookAliases maps a normalized name or abbreviation to its canonical
+// book name; bookAliasesByBook is the reverse, used for prefix matching.
+var bookAliases, bookAliasesByBook = buildBookAliases()
+
+func buildBookAliases() (map[string]string, map[string][]string) {
+	aliases := make(map[string]string)
+	byBook := make(map[string][]string)
+
+	add := func(alias, book string) {
+		key := normalizeBookName(alias)
+		if _, exists := aliases[key]; exists {
+			return
+		}
+		aliases[key] = book
+		byBook[book] = append(byBook[book], key)
+	}
+
+	for _, book := range biblicalOrder {
+		add(book, book)
+	}
+	for _, book := range biblicalOrder {
+		number, _, numbered := strings.Cut(book, " ")
+		if !numbered || number[0] < '0' || number[0] > '9' {
+			number = ""
+		}
+		for _, alias := range bookAliasTable[book] {
+			add(number+alias, book)
+		}
+	}
+
+	return aliases, byBook
+}
+
+var bookNumberPrefixes = []struct {
+	prefix string
+	number string
+}{
+	{"iii", "3"}, {"ii", "2"}, {"i", "1"},
+	{"first", "1"}, {"second", "2"}, {"third", "3"},
+	{"1st", "1"}, {"2nd", "2"}, {"3rd", "3"},
+}
+
+// normalizeBookName lowercases name, turns a leading roman numeral or
+// ordinal ("II Kings", "First John") into a digit and removes spaces
+// and punctuation, so "1 Co.", "I Cor" and "1Cor" all compare equal.
+func normalizeBookName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	for _, p := range bookNumberPrefixes {
+		rest, found := strings.CutPrefix(name, p.prefix)
+		if found && rest != "" && (rest[0] == ' ' || rest[0] == '.') {
+			name = p.number + rest
+			break
+		}
+	}
+
+	var b strings.Builder
+	for _, r := range name {
+		switch r {
+		case ' ', '.', '_', '-':
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// canonicalBook returns the biblicalOrder name for a book name or
+// abbreviation, or "" if it is not a known alias.
+func canonicalBook(name string) string {
+	return bookAliases[normalizeBookName(name)]
+}
+
+// resolveBook maps a user-supplied book name onto a book of this
+// translation. Exact aliases win; otherwise the name is treated as a
+// prefix and must identify exactly one book.
+func (bd *BibleData) resolveBook(name string) (string, error) {
+	key := normalizeBookName(name)
+	if key == "" {
+		return "", errUnknownBook
+	}
+
+	if canonical, ok := bookAliases[key]; ok {
+		if book := bd.bookByCanonicalName(canonical); book != "" {
+			return book, nil
+		}
+	}
+
+	for _, book := range bd.bookList {
+		if normalizeBookName(book) == key {
+			return book, nil
+		}
+	}
+
+	var candidates []string
+	for _, book := range bd.bookList {
+		names := []string{normalizeBookName(book)}
+		if canonical := canonicalBook(book); canonical != "" {
+			names = append(names, bookAliasesByBook[canonical]...)
+		}
+		for _, n := range names {
+			if strings.HasPrefix(n, key) {
+				candidates = append(candidates, book)
+				break
+			}
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return "", errUnknownBook
+	case 1:
+		return candidates[0], nil
+	default:
+		return "", &ambiguousBookError{name: name, candidates: candidates}
+	}
+}
+
+func (bd *BibleData) bookByCanonicalName(canonical string) string {
+	for _, book := range bd.bookList {
+		if book == canonical || canonicalBook(book) == canonical {
+			return book
+		}
+	}
+	return ""
+}
+
+// importedBookName names a book read from a translation file: a number
+// from 1 to 66 or a known alias becomes the biblicalOrder name, anything
+// else is kept as written.
+func importedBookName(name string) string {
+	if n, err := strconv.Atoi(name); err == nil && n >= 1 && n <= len(biblicalOrder) {
+		return biblicalOrder[n-1]
+	}
+	if canonical := canonicalBook(name); canonical != "" {
+		return canonical
+	}
+	return name
+}
+
+func (bd *BibleData) findBook(bookName string) string {
+	book, _ := bd.resolveBook(bookName)
+	return book
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeBookName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Genesis", "genesis"},
+		{"1 Co.", "1co"},
+		{"I Cor", "1cor"},
+		{"1Cor", "1cor"},
+		{"II Kings", "2kings"},
+		{"III John", "3john"},
+		{"First John", "1john"},
+		{"2nd Peter", "2peter"},
+		{"Song of Songs", "songofsongs"},
+		{"Isaiah", "isaiah"},
+	}
+	for _, tt := range tests {
+		if got := normalizeBookName(tt.name); got != tt.want {
+			t.Errorf("normalizeBookName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCanonicalBook(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Gen", "Genesis"},
+		{"Psalms", "Psalm"},
+		{"1 Sam", "1 Samuel"},
+		{"II Kgs", "2 Kings"},
+		{"Canticles", "Song Of Solomon"},
+		{"3 Jn", "3 John"},
+		{"Jn", "John"},
+		{"Rev", "Revelation"},
+		{"Tobit", ""},
+	}
+	for _, tt := range tests {
+		if got := canonicalBook(tt.name); got != tt.want {
+			t.Errorf("canonicalBook(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestResolveBook(t *testing.T) {
+	bd := &BibleData{bookList: []string{"Genesis", "Exodus", "Psalms", "Judges", "Jude", "John", "1 John", "Tobit"}}
+
+	tests := []struct {
+		name       string
+		want       string
+		candidates []string
+		err        error
+	}{
+		{name: "Gen", want: "Genesis"},
+		{name: "ex", want: "Exodus"},
+		{name: "Ps", want: "Psalms"},
+		{name: "Psalm", want: "Psalms"},
+		{name: "Jn", want: "John"},
+		{name: "I Jn", want: "1 John"},
+		{name: "Jude", want: "Jude"},
+		{name: "Tob", want: "Tobit"},
+		{name: "Jud", candidates: []string{"Judges", "Jude"}},
+		{name: "J", candidates: []string{"Judges", "Jude", "John"}},
+		{name: "Matt", err: errUnknownBook},
+		{name: "", err: errUnknownBook},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := bd.resolveBook(tt.name)
+			var ambiguous *ambiguousBookError
+			switch {
+			case tt.candidates != nil:
+				if !errors.As(err, &ambiguous) {
+					t.Fatalf("resolveBook(%q) = %q, %v, want an ambiguous book error", tt.name, got, err)
+				}
+				if !reflect.DeepEqual(ambiguous.candidates, tt.candidates) {
+					t.Errorf("candidates = %v, want %v", ambiguous.candidates, tt.candidates)
+				}
+			case tt.err != nil:
+				if !errors.Is(err, tt.err) {
+					t.Errorf("resolveBook(%q) error = %v, want %v", tt.name, err, tt.err)
+				}
+			default:
+				if err != nil || got != tt.want {
+					t.Errorf("resolveBook(%q) = %q, %v, want %q", tt.name, got, err, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestImportedBookName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"1", "Genesis"},
+		{"66", "Revelation"},
+		{"67", "67"},
+		{"Psalms", "Psalm"},
+		{"1Cor", "1 Corinthians"},
+		{"Tobit", "Tobit"},
+	}
+	for _, tt := range tests {
+		if got := importedBookName(tt.name); got != tt.want {
+			t.Errorf("importedBookName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// The index cache stores a parsed translation so it can be loaded without
+// re-parsing the source, and so without validating it again. Every cache
+// file records both versions below; a file written with other versions
+// is treated as stale and the source is parsed again.
+//
+// Bump cacheVersion when the layout of the cache file changes. Bump
+// parserVersion with every change to a parser, to the checks run while
+// loading, or to the way BibleData is built, since cached translations
+// would otherwise keep the old result.
+const (
+	cacheMagic    = "BIBLEGOIDX"
+	cacheVersion  = 2
+	parserVersion = 3
+)
+
+var errStaleCache = errors.New("stale index cache")
+
+// sourceStamp identifies one version of a translation source. For USFM
+// directories it covers every book file in the directory.
+type sourceStamp struct {
+	size    int64
+	modTime int64
+}
+
+func getCacheDir() (string, error) {
+	cacheDir := os.Getenv("XDG_CACHE_HOME")
+	if cacheDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		cacheDir = filepath.Join(homeDir, ".cache")
+	}
+	return filepath.Join(cacheDir, "bible-go"), nil
+}
+
+func cachePathFor(sourcePath string) (string, error) {
+	dir, err := getCacheDir()
+	if err != nil {
+		return "", err
+	}
+	absPath, err := filepath.Abs(sourcePath)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(absPath))
+	return filepath.Join(dir, hex.EncodeToString(sum[:16])+".idx"), nil
+}
+
+func statSource(path string) (sourceStamp, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return sourceStamp{}, err
+	}
+	if !info.IsDir() {
+		return sourceStamp{size: info.Size(), modTime: info.ModTime().UnixNano()}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return sourceStamp{}, err
+	}
+	stamp := sourceStamp{modTime: info.ModTime().UnixNano()}
+	for _, entry := range entries {
+		if entry.IsDir() || !isUSFMFile(entry.Name()) {
+			continue
+		}
+		entryInfo, err := entry.Info()
+		if err != nil {
+			return sourceStamp{}, err
+		}
+		stamp.size += entryInfo.Size()
+		stamp.modTime = max(stamp.modTime, entryInfo.ModTime().UnixNano())
+	}
+	return stamp, nil
+}
+
+// loadTranslationCached returns the translation at path from the index
+// cache when the cache matches the source, and otherwise parses the
+// source and refreshes the cache. Cache failures never prevent loading.
+func loadTranslationCached(path string) (*BibleData, error) {
+	stamp, err := statSource(path)
+	if err != nil {
+		return nil, &ReadError{Path: path, Err: err}
+	}
+
+	cachePath, err := cachePathFor(path)
+	if err != nil {
+		return loadTranslationFile(path)
+	}
+
+	if bd, err := readIndexCache(cachePath, stamp); err == nil {
+		return bd, nil
+	}
+
+	bd, err := loadTranslationFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	writeIndexCache(cachePath, stamp, bd)
+	return bd, nil
+}
+
+func readIndexCache(cachePath string, stamp sourceStamp) (*BibleData, error) {
+	f, err := os.Open(cachePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := &cacheReader{r: bufio.NewReaderSize(f, 1<<16)}
+
+	if magic := r.bytes(len(cacheMagic)); string(magic) != cacheMagic {
+		return nil, errStaleCache
+	}
+	if r.uint() != cacheVersion || r.uint() != parserVersion || int64(r.uint()) != stamp.size || r.int() != stamp.modTime {
+		return nil, errStaleCache
+	}
+
+	bd := &BibleData{
+		bookList:     make([]string, r.count()),
+		chapterIndex: make(map[string]map[int][]Verse),
+	}
+	for i := range bd.bookList {
+		bd.bookList[i] = r.string()
+	}
+
+	bd.verses = make([]Verse, r.count())
+	for i := range bd.verses {
+		bookIdx := r.uint()
+		if r.err == nil && bookIdx >= uint64(len(bd.bookList)) {
+			return nil, fmt.Errorf("corrupt index cache: book %d out of range", bookIdx)
+		}
+		verse := Verse{
+			Chapter: int(r.uint()),
+			Verse:   int(r.uint()),
+			Text:    r.string(),
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+		verse.Book = bd.bookList[bookIdx]
+		bd.verses[i] = verse
+
+		if bd.chapterIndex[verse.Book] == nil {
+			bd.chapterIndex[verse.Book] = make(map[int][]Verse)
+		}
+		bd.chapterIndex[verse.Book][verse.Chapter] = append(bd.chapterIndex[verse.Book][verse.Chapter], verse)
+	}
+
+	words := r.count()
+	bd.index = make(map[string][]int, words)
+	for i := 0; i < words && r.err == nil; i++ {
+		word := r.string()
+		positions := make([]int, r.count())
+		last := 0
+		for j := range positions {
+			last += int(r.uint())
+			if r.err == nil && last >= len(bd.verses) {
+				return nil, fmt.Errorf("corrupt index cache: verse %d out of range", last)
+			}
+			positions[j] = last
+		}
+		bd.index[word] = positions
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+	return bd, nil
+}
+
+// writeIndexCache stores bd next to other caches. The file is written to a
+// temporary name and renamed so a crash never leaves a truncated cache.
+func writeIndexCache(cachePath string, stamp sourceStamp, bd *BibleData) {
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0o755); err != nil {
+		return
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(cachePath), "idx-*.tmp")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	w := &cacheWriter{w: bufio.NewWriterSize(tmp, 1<<16)}
+	w.bytes([]byte(cacheMagic))
+	w.uint(cacheVersion)
+	w.uint(parserVersion)
+	w.uint(uint64(stamp.size))
+	w.int(stamp.modTime)
+
+	bookIndex := make(map[string]uint64, len(bd.bookList))
+	w.uint(uint64(len(bd.bookList)))
+	for i, book := range bd.bookList {
+		bookIndex[book] = uint64(i)
+		w.string(book)
+	}
+
+	w.uint(uint64(len(bd.verses)))
+	for _, verse := range bd.verses {
+		w.uint(bookIndex[verse.Book])
+		w.uint(uint64(verse.Chapter))
+		w.uint(uint64(verse.Verse))
+		w.string(verse.Text)
+	}
+
+	words := make([]string, 0, len(bd.index))
+	for word := range bd.index {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+
+	w.uint(uint64(len(words)))
+	for _, word := range words {
+		w.string(word)
+		positions := bd.index[word]
+		w.uint(uint64(len(positions)))
+		last := 0
+		for _, pos := range positions {
+			w.uint(uint64(pos - last))
+			last = pos
+		}
+	}
+
+	if w.err == nil {
+		w.err = w.w.Flush()
+	}
+	if err := tmp.Close(); err != nil || w.err != nil {
+		return
+	}
+	os.Rename(tmp.Name(), cachePath)
+}
+
+type cacheWriter struct {
+	w   *bufio.Writer
+	buf [binary.MaxVarintLen64]byte
+	err error
+}
+
+func (w *cacheWriter) bytes(b []byte) {
+	if w.err == nil {
+		_, w.err = w.w.Write(b)
+	}
+}
+
+func (w *cacheWriter) uint(v uint64) {
+	w.bytes(w.buf[:binary.PutUvarint(w.buf[:], v)])
+}
+
+func (w *cacheWriter) int(v int64) {
+	w.bytes(w.buf[:binary.PutVarint(w.buf[:], v)])
+}
+
+func (w *cacheWriter) string(s string) {
+	w.uint(uint64(len(s)))
+	if w.err == nil {
+		_, w.err = w.w.WriteString(s)
+	}
+}
+
+// cacheReader records the first error and returns zero values after it,
+// so callers can check r.err once per record.
+type cacheReader struct {
+	r   *bufio.Reader
+	err error
+}
+
+func (r *cacheReader) bytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	b := make([]byte, n)
+	_, r.err = io.ReadFull(r.r, b)
+	return b
+}
+
+func (r *cacheReader) uint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	var v uint64
+	v, r.err = binary.ReadUvarint(r.r)
+	return v
+}
+
+func (r *cacheReader) int() int64 {
+	if r.err != nil {
+		return 0
+	}
+	var v int64
+	v, r.err = binary.ReadVarint(r.r)
+	return v
+}
+
+// count reads a length prefix, rejecting values no translation could
+// produce so a corrupt file cannot trigger a huge allocation.
+func (r *cacheReader) count() int {
+	n := r.uint()
+	if r.err == nil && n > 1<<24 {
+		r.err = fmt.Errorf("corrupt index cache: length %d", n)
+	}
+	if r.err != nil {
+		return 0
+	}
+	return int(n)
+}
+
+func (r *cacheReader) string() string {
+	n := r.count()
+	if r.err != nil {
+		return ""
+	}
+	return string(r.bytes(n))
+}
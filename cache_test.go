@@ -0,0 +1,108 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const cacheTestBible = `{
+	"Genesis": {"1": {"1": "In the beginning God created", "2": "And the earth was without form"}},
+	"John": {"3": {"16": "For God so loved the world"}, "1": {"1": "In the beginning was the Word"}}
+}`
+
+func TestIndexCacheRoundTrip(t *testing.T) {
+	want, err := NewBibleData([]byte(cacheTestBible))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cachePath := filepath.Join(t.TempDir(), "test.idx")
+	stamp := sourceStamp{size: 123, modTime: 456}
+	writeIndexCache(cachePath, stamp, want)
+
+	got, err := readIndexCache(cachePath, stamp)
+	if err != nil {
+		t.Fatalf("readIndexCache: %v", err)
+	}
+	if !reflect.DeepEqual(got.bookList, want.bookList) {
+		t.Errorf("bookList = %v, want %v", got.bookList, want.bookList)
+	}
+	if !reflect.DeepEqual(got.verses, want.verses) {
+		t.Errorf("verses = %v, want %v", got.verses, want.verses)
+	}
+	if !reflect.DeepEqual(got.chapterIndex, want.chapterIndex) {
+		t.Errorf("chapterIndex = %v, want %v", got.chapterIndex, want.chapterIndex)
+	}
+	if !reflect.DeepEqual(got.index, want.index) {
+		t.Errorf("index = %v, want %v", got.index, want.index)
+	}
+}
+
+func TestIndexCacheStale(t *testing.T) {
+	bd, err := NewBibleData([]byte(cacheTestBible))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stamp := sourceStamp{size: 123, modTime: 456}
+
+	tests := []struct {
+		name    string
+		stamp   sourceStamp
+		corrupt func(data []byte)
+	}{
+		{name: "size changed", stamp: sourceStamp{size: 124, modTime: 456}},
+		{name: "modification time changed", stamp: sourceStamp{size: 123, modTime: 457}},
+		{name: "cache version changed", stamp: stamp, corrupt: func(data []byte) { data[len(cacheMagic)]++ }},
+		{name: "parser version changed", stamp: stamp, corrupt: func(data []byte) { data[len(cacheMagic)+1]++ }},
+		{name: "not a cache file", stamp: stamp, corrupt: func(data []byte) { data[0] = 'X' }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cachePath := filepath.Join(t.TempDir(), "test.idx")
+			writeIndexCache(cachePath, stamp, bd)
+			if tt.corrupt != nil {
+				data, err := os.ReadFile(cachePath)
+				if err != nil {
+					t.Fatal(err)
+				}
+				tt.corrupt(data)
+				if err := os.WriteFile(cachePath, data, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if _, err := readIndexCache(cachePath, tt.stamp); !errors.Is(err, errStaleCache) {
+				t.Errorf("readIndexCache error = %v, want %v", err, errStaleCache)
+			}
+		})
+	}
+}
+
+func TestLoadTranslationCachedRefreshesCache(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	path := filepath.Join(t.TempDir(), "TST_bible.json")
+	if err := os.WriteFile(path, []byte(cacheTestBible), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := loadTranslationCached(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cachePath, err := cachePathFor(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(cachePath); err != nil {
+		t.Fatalf("cache not written: %v", err)
+	}
+
+	second, err := loadTranslationCached(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(first.verses, second.verses) {
+		t.Errorf("cached verses = %v, want %v", second.verses, first.verses)
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aymanbagabas/go-osc52/v2"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const defaultCopyTemplate = `"{text}" — {reference} ({translation})`
+
+// citation formats verses, which must be consecutive verses of one
+// chapter, with the configured copy template. The template may use
+// {text}, {book}, {chapter}, {verse}, {reference} and {translation};
+// {verse} is a range such as "28-39" for several verses.
+func (m model) citation(verses []Verse) string {
+	first, last := verses[0], verses[len(verses)-1]
+	verseRange := strconv.Itoa(first.Verse)
+	if len(verses) > 1 {
+		verseRange = fmt.Sprintf("%d-%d", first.Verse, last.Verse)
+	}
+
+	texts := make([]string, len(verses))
+	for i, verse := range verses {
+		texts[i] = verse.Text
+		if m.config.CopyVerseNumbers {
+			texts[i] = fmt.Sprintf("%d %s", verse.Verse, verse.Text)
+		}
+	}
+
+	template := m.config.CopyTemplate
+	if template == "" {
+		template = defaultCopyTemplate
+	}
+	return strings.NewReplacer(
+		"{text}", strings.Join(texts, " "),
+		"{book}", first.Book,
+		"{chapter}", strconv.Itoa(first.Chapter),
+		"{verse}", verseRange,
+		"{reference}", formatPassage(verses),
+		"{translation}", m.currentTranslation,
+	).Replace(template)
+}
+
+// The clipboard is set with an OSC 52 escape sequence. The terminal does
+// the copying, so it works over SSH; inside tmux and screen the sequence
+// is wrapped to pass through to the outer terminal. The sequence is sent
+// as part of the next frame rather than written by a command, which could
+// land in the middle of a frame. It stays in the view for clipboardHold,
+// long enough for a frame to be drawn; the renderer skips lines that did
+// not change, so it is sent once.
+const clipboardHold = 200 * time.Millisecond
+
+type clipboardSentMsg struct {
+	seq int
+}
+
+func clipboardSequence(text string) string {
+	seq := osc52.New(text)
+	switch {
+	case os.Getenv("TMUX") != "":
+		seq = seq.Tmux()
+	case strings.HasPrefix(os.Getenv("TERM"), "screen"):
+		seq = seq.Screen()
+	}
+	return seq.String()
+}
+
+// outputIsTerminal reports whether the program draws to a terminal, which
+// the escape sequence must reach to set the clipboard.
+func outputIsTerminal() bool {
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// yank copies the selected verses, or the visual selection, as a
+// citation.
+func (m *model) yank(verses []Verse) tea.Cmd {
+	if len(verses) == 0 {
+		return nil
+	}
+	if !outputIsTerminal() {
+		m.statusMessage = "Cannot copy: output is not a terminal"
+		return nil
+	}
+	m.clipboardSeq++
+	m.clipboard = clipboardSequence(m.citation(verses))
+	m.statusMessage = fmt.Sprintf("Copied %s", formatPassage(verses))
+	seq := m.clipboardSeq
+	return tea.Tick(clipboardHold, func(time.Time) tea.Msg {
+		return clipboardSentMsg{seq: seq}
+	})
+}
+
+// clipboardSent drops the sequence from the view once it has been drawn,
+// unless a newer copy replaced it.
+func (m *model) clipboardSent(msg clipboardSentMsg) {
+	if msg.seq == m.clipboardSeq {
+		m.clipboard = ""
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import "testing"
+
+func TestCitation(t *testing.T) {
+	tests := []struct {
+		name         string
+		template     string
+		verseNumbers bool
+		first, last  int
+		want         string
+	}{
+		{name: "default template", first: 0, last: 0, want: `"In the beginning God created" — Genesis 1:1 (TST)`},
+		{name: "default template on a passage", first: 0, last: 1, want: `"In the beginning God created And the earth was without form" — Genesis 1:1-2 (TST)`},
+		{name: "every placeholder", template: "{book}|{chapter}|{verse}|{reference}|{translation}", first: 2, last: 3, want: "Genesis|1|3-5|Genesis 1:3-5|TST"},
+		{name: "repeated placeholder", template: "{verse} {verse}", first: 1, last: 1, want: "2 2"},
+		{name: "verse numbers", template: "{text}", verseNumbers: true, first: 0, last: 1, want: "1 In the beginning God created 2 And the earth was without form"},
+		{name: "unknown placeholder", template: "{text} {version}", first: 0, last: 0, want: "In the beginning God created {version}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel(t)
+			m.config.CopyTemplate = tt.template
+			m.config.CopyVerseNumbers = tt.verseNumbers
+			if got := m.citation(m.verses[tt.first : tt.last+1]); got != tt.want {
+				t.Errorf("citation() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
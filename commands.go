@@ -0,0 +1,338 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// A command is run from command mode, entered with ':'. args is the rest
+// of the line after the command name.
+type command struct {
+	name     string
+	complete func(m model) []string
+	run      func(m *model, args string) tea.Cmd
+}
+
+var commands []command
+
+func init() {
+	commands = []command{
+		{name: "goto", complete: (model).bookNames, run: (*model).gotoCommand},
+		{name: "tr", complete: (model).translationNames, run: (*model).translationCommand},
+		{name: "book", complete: (model).bookNames, run: (*model).bookCommand},
+		{name: "chapter", run: (*model).chapterCommand},
+		{name: "zen", run: func(m *model, args string) tea.Cmd {
+			m.zenMode = !m.zenMode
+			return nil
+		}},
+		{name: "bookmark", run: func(m *model, args string) tea.Cmd {
+			m.toggleBookmark(m.selectedVerses())
+			m.visual = false
+			return nil
+		}},
+		{name: "export", complete: func(model) []string { return []string{"md"} }, run: (*model).exportCommand},
+		{name: "marks", run: func(m *model, args string) tea.Cmd {
+			m.openMarks()
+			return nil
+		}},
+		{name: "quit", run: func(m *model, args string) tea.Cmd {
+			m.saveCurrentState()
+			return tea.Quit
+		}},
+	}
+}
+
+// lookupCommand finds a command by name or unique prefix, so ":q" quits
+// and ":ch 3" changes chapter.
+func lookupCommand(name string) (command, error) {
+	var matches []command
+	for _, c := range commands {
+		if c.name == name {
+			return c, nil
+		}
+		if strings.HasPrefix(c.name, name) {
+			matches = append(matches, c)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return command{}, fmt.Errorf("unknown command %q", name)
+	case 1:
+		return matches[0], nil
+	default:
+		names := make([]string, len(matches))
+		for i, c := range matches {
+			names[i] = c.name
+		}
+		return command{}, fmt.Errorf("ambiguous command %q (could be %s)", name, strings.Join(names, ", "))
+	}
+}
+
+func (m *model) openCommandLine() {
+	m.mode = commandMode
+	m.commandInput = ""
+	m.completions = nil
+}
+
+func (m *model) closeCommandLine() {
+	m.mode = navigationMode
+	m.commandInput = ""
+	m.completions = nil
+}
+
+// handleCommandKey edits the command line. It is separate from the search
+// input so typing a command never triggers navigation keys.
+func (m *model) handleCommandKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.closeCommandLine()
+	case tea.KeyEnter:
+		input := m.commandInput
+		m.closeCommandLine()
+		return m.runCommand(input)
+	case tea.KeyBackspace:
+		if m.commandInput == "" {
+			m.closeCommandLine()
+			return nil
+		}
+		runes := []rune(m.commandInput)
+		m.commandInput = string(runes[:len(runes)-1])
+		m.completions = nil
+	case tea.KeyTab:
+		m.completeCommand(1)
+	case tea.KeyShiftTab:
+		m.completeCommand(-1)
+	case tea.KeySpace:
+		m.commandInput += " "
+		m.completions = nil
+	case tea.KeyRunes:
+		m.commandInput += string(msg.Runes)
+		m.completions = nil
+	}
+	return nil
+}
+
+func (m *model) runCommand(input string) tea.Cmd {
+	name, args, _ := strings.Cut(strings.TrimSpace(input), " ")
+	if name == "" {
+		return nil
+	}
+	c, err := lookupCommand(name)
+	if err != nil {
+		m.statusMessage = err.Error()
+		return nil
+	}
+	return c.run(m, strings.TrimSpace(args))
+}
+
+// completeCommand completes the command name or its argument. Repeated
+// presses cycle through the candidates in the given direction.
+func (m *model) completeCommand(direction int) {
+	if m.completions == nil {
+		m.completions = m.commandCompletions()
+		m.completionIndex = -1
+		if direction < 0 {
+			m.completionIndex = 0
+		}
+	}
+	if len(m.completions) == 0 {
+		return
+	}
+
+	m.completionIndex = (m.completionIndex + direction + len(m.completions)) % len(m.completions)
+	name, _, hasArgs := strings.Cut(m.commandInput, " ")
+	if hasArgs {
+		m.commandInput = name + " " + m.completions[m.completionIndex]
+	} else {
+		m.commandInput = m.completions[m.completionIndex]
+	}
+}
+
+// commandCompletions returns the candidates for the word being typed:
+// command names before the first space, then the command's arguments,
+// matched case-insensitively by prefix.
+func (m model) commandCompletions() []string {
+	name, args, hasArgs := strings.Cut(m.commandInput, " ")
+	var candidates []string
+	prefix := name
+	if !hasArgs {
+		for _, c := range commands {
+			candidates = append(candidates, c.name)
+		}
+	} else {
+		c, err := lookupCommand(name)
+		if err != nil || c.complete == nil {
+			return []string{}
+		}
+		candidates = c.complete(m)
+		prefix = strings.TrimLeft(args, " ")
+	}
+
+	matches := []string{}
+	for _, candidate := range candidates {
+		if strings.HasPrefix(strings.ToLower(candidate), strings.ToLower(prefix)) {
+			matches = append(matches, candidate)
+		}
+	}
+	return matches
+}
+
+func (m model) bookNames() []string {
+	return m.getBibleData().GetBooks()
+}
+
+func (m model) translationNames() []string {
+	return m.multiBibleData.translationNames
+}
+
+func (m *model) gotoCommand(args string) tea.Cmd {
+	ranges, ok := m.getBibleData().parseReference(args)
+	if !ok || len(ranges) == 0 {
+		m.statusMessage = fmt.Sprintf("goto: %q is not a reference", args)
+		return nil
+	}
+	target := verseKey{book: ranges[0].book, chapter: ranges[0].startChapter, verse: max(1, ranges[0].startVerse)}
+	m.recordJump()
+	return m.jumpTo(m.currentTranslation, target)
+}
+
+func (m *model) translationCommand(args string) tea.Cmd {
+	for _, name := range m.multiBibleData.translationNames {
+		if strings.EqualFold(name, args) {
+			if err := m.multiBibleData.LoadError(name); err != nil {
+				m.statusMessage = err.Error()
+				return nil
+			}
+			return tea.Batch(m.changeTranslation(name), m.loadParallelColumns())
+		}
+	}
+	m.statusMessage = fmt.Sprintf("tr: unknown translation %q (available: %s)", args, strings.Join(m.multiBibleData.translationNames, ", "))
+	return nil
+}
+
+func (m *model) bookCommand(args string) tea.Cmd {
+	bibleData := m.getBibleData()
+	book, err := bibleData.resolveBook(args)
+	if err != nil {
+		if errors.Is(err, errUnknownBook) {
+			err = fmt.Errorf("unknown book %q", args)
+		}
+		m.statusMessage = "book: " + err.Error()
+		return nil
+	}
+	m.recordJump()
+	m.currentBook = book
+	m.currentChapter = 1
+	m.resetVerseView(bibleData)
+	return nil
+}
+
+func (m *model) chapterCommand(args string) tea.Cmd {
+	chapter, err := strconv.Atoi(args)
+	bibleData := m.getBibleData()
+	if err != nil || len(bibleData.GetVerses(m.currentBook, chapter)) == 0 {
+		m.statusMessage = fmt.Sprintf("chapter: %s has no chapter %q", m.currentBook, args)
+		return nil
+	}
+	m.recordJump()
+	m.currentChapter = chapter
+	m.resetVerseView(bibleData)
+	return nil
+}
+
+func getExportsDir() (string, error) {
+	configDir, err := getConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "exports"), nil
+}
+
+// exportCommand writes the current chapter as markdown, with highlighted
+// verses in bold and notes as quotes below their verse. Without a file
+// name it goes to the exports directory and never replaces an earlier
+// export; a file that is named is overwritten.
+func (m *model) exportCommand(args string) tea.Cmd {
+	format, path, _ := strings.Cut(args, " ")
+	if format != "md" {
+		m.statusMessage = "export: usage: export md [FILE]"
+		return nil
+	}
+	path = strings.TrimSpace(path)
+	overwrite := path != ""
+	if path == "" {
+		exportsDir, err := getExportsDir()
+		if err == nil {
+			err = os.MkdirAll(exportsDir, 0o755)
+		}
+		if err != nil {
+			m.statusMessage = fmt.Sprintf("export: %v", err)
+			return nil
+		}
+		path = filepath.Join(exportsDir, fmt.Sprintf("%s-%d-%s.md", strings.ReplaceAll(m.currentBook, " ", "_"), m.currentChapter, m.currentTranslation))
+	}
+
+	var doc strings.Builder
+	fmt.Fprintf(&doc, "# %s %d (%s)\n\n", m.currentBook, m.currentChapter, m.currentTranslation)
+	for _, verse := range m.verses {
+		text := verse.Text
+		if _, ok := m.highlightOf(verse); ok {
+			text = "**" + text + "**"
+		}
+		fmt.Fprintf(&doc, "<sup>%d</sup> %s\n\n", verse.Verse, text)
+		if note, ok := m.notes[m.multiBibleData.canonicalKey(m.currentTranslation, verse)]; ok {
+			for _, line := range strings.Split(noteBody(note), "\n") {
+				fmt.Fprintf(&doc, "> %s\n", line)
+			}
+			doc.WriteString("\n")
+		}
+	}
+
+	if err := writeExport(path, doc.String(), overwrite); err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			m.statusMessage = fmt.Sprintf("export: %s already exists; give a file name to overwrite it", path)
+			return nil
+		}
+		m.statusMessage = fmt.Sprintf("export: %v", err)
+		return nil
+	}
+	m.statusMessage = fmt.Sprintf("Exported %s %d to %s", m.currentBook, m.currentChapter, path)
+	return nil
+}
+
+// writeExport writes doc to path, failing with fs.ErrExist if the file
+// exists and overwrite is not set.
+func writeExport(path, doc string, overwrite bool) error {
+	flags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if overwrite {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+	f, err := os.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(doc); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// renderCommandLine renders the command being typed in place of the help
+// line, followed by the completion candidates.
+func (m model) renderCommandLine() string {
+	line := ":" + m.commandInput
+	if len(m.completions) > 1 {
+		hint := strings.Join(m.completions, " ")
+		line += "  " + lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Render(truncateText(hint, max(10, m.width-lipgloss.Width(line)-3)))
+	}
+	return m.bookStyle.Render(line)
+}
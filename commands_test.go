@@ -0,0 +1,109 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestLookupCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		wantErr string
+	}{
+		{name: "goto", want: "goto"},
+		{name: "q", want: "quit"},
+		{name: "ch", want: "chapter"},
+		{name: "book", want: "book"},
+		{name: "bookm", want: "bookmark"},
+		{name: "b", wantErr: `ambiguous command "b" (could be book, bookmark)`},
+		{name: "nope", wantErr: `unknown command "nope"`},
+		{name: "Quit", wantErr: `unknown command "Quit"`},
+	}
+	for _, tt := range tests {
+		c, err := lookupCommand(tt.name)
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("lookupCommand(%q) error = %v, want %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || c.name != tt.want {
+			t.Errorf("lookupCommand(%q) = %q, %v, want %q", tt.name, c.name, err, tt.want)
+		}
+	}
+}
+
+func TestCommandCompletions(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"bo", []string{"book", "bookmark"}},
+		{"Z", []string{"zen"}},
+		{"x", []string{}},
+		{"goto ", []string{"Genesis", "John"}},
+		{"goto j", []string{"John"}},
+		{"b  ge", []string{}},
+		{"book  ge", []string{"Genesis"}},
+		{"tr ts", []string{"TST"}},
+		{"ex ", []string{"md"}},
+		{"chapter 1", []string{}},
+		{"nope x", []string{}},
+	}
+	for _, tt := range tests {
+		m := newTestModel(t)
+		m.commandInput = tt.input
+		if got := m.commandCompletions(); !slices.Equal(got, tt.want) {
+			t.Errorf("commandCompletions(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+	m := newTestModel(t)
+	if got := m.commandCompletions(); len(got) != len(commands) {
+		t.Errorf("commandCompletions(\"\") = %q, want every command", got)
+	}
+}
+
+func TestWriteExport(t *testing.T) {
+	tests := []struct {
+		name      string
+		existing  string
+		overwrite bool
+		wantErr   error
+		want      string
+	}{
+		{name: "new file", want: "# Genesis 1\n"},
+		{name: "new file with overwrite", overwrite: true, want: "# Genesis 1\n"},
+		{name: "existing file", existing: "earlier export\n", wantErr: fs.ErrExist, want: "earlier export\n"},
+		{name: "existing file with overwrite", existing: "a much longer earlier export\n", overwrite: true, want: "# Genesis 1\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "Genesis-1-TST.md")
+			if tt.existing != "" {
+				if err := os.WriteFile(path, []byte(tt.existing), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			err := writeExport(path, "# Genesis 1\n", tt.overwrite)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("writeExport error = %v, want %v", err, tt.wantErr)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(data); got != tt.want {
+				t.Errorf("file = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if err := writeExport(filepath.Join(t.TempDir(), "missing", "x.md"), "", false); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("writeExport into a missing directory error = %v, want %v", err, fs.ErrNotExist)
+	}
+}
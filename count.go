@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Counts work like vim's: digits typed before a motion repeat it, so 5j
+// moves down five verses and 3l forward three chapters, and 12G goes to
+// verse 12. The count is shown in the status line while it is typed.
+
+// addCountDigit adds r to the count if it is a digit. A leading 0 is not
+// a count.
+func (m *model) addCountDigit(r rune) bool {
+	if r < '0' || r > '9' || (r == '0' && m.count == 0) {
+		return false
+	}
+	m.count = min(m.count*10+int(r-'0'), 99999)
+	m.statusMessage = strconv.Itoa(m.count)
+	return true
+}
+
+// takeCount returns the count typed before the current key, 1 if there
+// is none, and resets it.
+func (m *model) takeCount() (int, bool) {
+	count := m.count
+	m.count = 0
+	return max(1, count), count > 0
+}
+
+func repeat(count int, motion func()) {
+	for range count {
+		motion()
+	}
+}
+
+// selectNumber selects verse n of the chapter, or item n of a list. A
+// verse missing from the chapter selects the last verse before it.
+func (m *model) selectNumber(n int) {
+	listLen, ok := m.getActiveList()
+	if !ok || listLen == 0 {
+		return
+	}
+	if m.mode != navigationMode {
+		m.selected = min(n, listLen) - 1
+		m.adjustScrollOffset(listLen, m.getVisibleVerses())
+		return
+	}
+
+	m.selected = 0
+	for i, verse := range m.verses {
+		if verse.Verse <= n {
+			m.selected = i
+		}
+	}
+	if m.verses[m.selected].Verse != n {
+		m.statusMessage = fmt.Sprintf("%s %d has no verse %d", m.currentBook, m.currentChapter, n)
+	}
+	m.adjustScrollOffset(listLen, m.getVisibleVerses())
+}
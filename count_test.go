@@ -0,0 +1,61 @@
+package main
+
+import "testing"
+
+func TestAddCountDigit(t *testing.T) {
+	tests := []struct {
+		typed        string
+		wantAccepted []bool
+		wantCount    int
+	}{
+		{"5", []bool{true}, 5},
+		{"12", []bool{true, true}, 12},
+		{"0", []bool{false}, 0},
+		{"10", []bool{true, true}, 10},
+		{"3j", []bool{true, false}, 3},
+		{"1234567", []bool{true, true, true, true, true, true, true}, 99999},
+	}
+	for _, tt := range tests {
+		m := newTestModel(t)
+		for i, r := range tt.typed {
+			if got := m.addCountDigit(r); got != tt.wantAccepted[i] {
+				t.Errorf("%q: addCountDigit(%q) = %v, want %v", tt.typed, r, got, tt.wantAccepted[i])
+			}
+		}
+		count, typed := m.takeCount()
+		if count != max(1, tt.wantCount) || typed != (tt.wantCount > 0) {
+			t.Errorf("%q: takeCount() = %d, %v, want %d, %v", tt.typed, count, typed, max(1, tt.wantCount), tt.wantCount > 0)
+		}
+		if count, typed := m.takeCount(); count != 1 || typed {
+			t.Errorf("%q: second takeCount() = %d, %v, want 1, false", tt.typed, count, typed)
+		}
+	}
+}
+
+func TestSelectNumber(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         mode
+		n            int
+		wantSelected int
+		wantStatus   string
+	}{
+		{name: "first verse", n: 1, wantSelected: 0},
+		{name: "verse after a gap", n: 5, wantSelected: 3},
+		{name: "missing verse", n: 4, wantSelected: 2, wantStatus: "Genesis 1 has no verse 4"},
+		{name: "past the last verse", n: 40, wantSelected: 3, wantStatus: "Genesis 1 has no verse 40"},
+		{name: "search result", mode: searchMode, n: 2, wantSelected: 1},
+		{name: "past the last search result", mode: searchMode, n: 9, wantSelected: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel(t)
+			m.mode = tt.mode
+			m.searchResults = m.verses
+			m.selectNumber(tt.n)
+			if m.selected != tt.wantSelected || m.statusMessage != tt.wantStatus {
+				t.Errorf("selected %d with status %q, want %d with status %q", m.selected, m.statusMessage, tt.wantSelected, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// NewBibleDataFromDelimited parses flat "book, chapter, verse, text"
+// rows separated by tabs or commas. A header row is skipped, the book
+// column may hold a name, an abbreviation or a number from 1 to 66, and
+// any further columns are joined back into the verse text.
+func NewBibleDataFromDelimited(data []byte, separator rune) (*BibleData, error) {
+	bible := make(Bible)
+
+	rows, err := readDelimitedRows(data, separator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse delimited bible: %w", err)
+	}
+
+	for i, row := range rows {
+		if len(row) < 4 {
+			if len(row) == 1 && strings.TrimSpace(row[0]) == "" {
+				continue
+			}
+			return nil, fmt.Errorf("failed to parse delimited bible: line %d has %d columns, expected book, chapter, verse and text", i+1, len(row))
+		}
+
+		chapter, chapterErr := strconv.Atoi(strings.TrimSpace(row[1]))
+		verse, verseErr := strconv.Atoi(strings.TrimSpace(row[2]))
+		if chapterErr != nil || verseErr != nil {
+			if i == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("failed to parse delimited bible: line %d has a non-numeric chapter or verse", i+1)
+		}
+
+		book := importedBookName(strings.TrimSpace(row[0]))
+		if bible[book] == nil {
+			bible[book] = make(map[string]map[string]string)
+		}
+		chapterKey := strconv.Itoa(chapter)
+		if bible[book][chapterKey] == nil {
+			bible[book][chapterKey] = make(map[string]string)
+		}
+		text := strings.Join(row[3:], string(separator))
+		bible[book][chapterKey][strconv.Itoa(verse)] = strings.TrimSpace(text)
+	}
+
+	if len(bible) == 0 {
+		return nil, fmt.Errorf("failed to parse delimited bible: no verses found")
+	}
+
+	return newBibleDataFromBible(bible), nil
+}
+
+// readDelimitedRows splits tab-separated data line by line, since verse
+// text often contains quotes, and comma-separated data with encoding/csv.
+func readDelimitedRows(data []byte, separator rune) ([][]string, error) {
+	if separator == '\t' {
+		var rows [][]string
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			rows = append(rows, strings.Split(strings.TrimRight(scanner.Text(), "\r"), "\t"))
+		}
+		return rows, scanner.Err()
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = separator
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var rows [][]string
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+}
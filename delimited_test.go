@@ -0,0 +1,81 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewBibleDataFromDelimited(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		separator rune
+		want      []Verse
+	}{
+		{
+			name:      "tsv with header",
+			data:      "book\tchapter\tverse\ttext\nGenesis\t1\t1\tIn the beginning\nGen\t1\t2\tAnd the earth\n",
+			separator: '\t',
+			want: []Verse{
+				{Book: "Genesis", Chapter: 1, Verse: 1, Text: "In the beginning"},
+				{Book: "Genesis", Chapter: 1, Verse: 2, Text: "And the earth"},
+			},
+		},
+		{
+			name:      "tsv with book numbers, quotes and CRLF",
+			data:      "43\t3\t16\tFor God so \"loved\" the world\r\n\r\n",
+			separator: '\t',
+			want:      []Verse{{Book: "John", Chapter: 3, Verse: 16, Text: `For God so "loved" the world`}},
+		},
+		{
+			name:      "tsv with extra columns joined into the text",
+			data:      "Ps\t23\t1\tThe LORD is my shepherd\tI shall not want\n",
+			separator: '\t',
+			want:      []Verse{{Book: "Psalm", Chapter: 23, Verse: 1, Text: "The LORD is my shepherd\tI shall not want"}},
+		},
+		{
+			name:      "csv with quoted text",
+			data:      "Book,Chapter,Verse,Text\n1 John,4,8,\"He that loveth not, knoweth not God\"\n",
+			separator: ',',
+			want:      []Verse{{Book: "1 John", Chapter: 4, Verse: 8, Text: "He that loveth not, knoweth not God"}},
+		},
+		{
+			name:      "csv with unquoted commas",
+			data:      "Jude,1,2,Mercy unto you, and peace\n",
+			separator: ',',
+			want:      []Verse{{Book: "Jude", Chapter: 1, Verse: 2, Text: "Mercy unto you, and peace"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bd, err := NewBibleDataFromDelimited([]byte(tt.data), tt.separator)
+			if err != nil {
+				t.Fatalf("NewBibleDataFromDelimited: %v", err)
+			}
+			if !reflect.DeepEqual(bd.verses, tt.want) {
+				t.Errorf("verses = %q, want %q", bd.verses, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewBibleDataFromDelimitedErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		message string
+	}{
+		{name: "too few columns", data: "Genesis\t1\t1\tIn the beginning\nGenesis\t1\n", message: "line 2 has 2 columns"},
+		{name: "non-numeric verse", data: "Genesis\t1\t1\tIn the beginning\nGenesis\t1\tx\tAnd\n", message: "line 2 has a non-numeric chapter or verse"},
+		{name: "header only", data: "book\tchapter\tverse\ttext\n", message: "no verses found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBibleDataFromDelimited([]byte(tt.data), '\t')
+			if err == nil || !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("error = %v, want it to mention %q", err, tt.message)
+			}
+		})
+	}
+}
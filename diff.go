@@ -0,0 +1,399 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+type diffKind int
+
+const (
+	diffEqual diffKind = iota
+	diffDelete
+	diffInsert
+	diffSubstitute
+)
+
+// diffOp is one run of a word diff. Equal runs carry the same words in
+// old and new; deletions only old, insertions only new, and substitutions
+// the old words together with the new words replacing them.
+type diffOp struct {
+	kind diffKind
+	old  []string
+	new  []string
+}
+
+// verseDiff compares a verse of one translation with the same passage in
+// another. missing is set when the other translation lacks the verse.
+type verseDiff struct {
+	ref     verseKey
+	target  verseKey
+	ops     []diffOp
+	missing bool
+}
+
+func (d verseDiff) changed() bool {
+	for _, op := range d.ops {
+		if op.kind != diffEqual {
+			return true
+		}
+	}
+	return false
+}
+
+// diffWords computes a word-level diff of two texts from their longest
+// common subsequence of words. A deletion directly followed by an
+// insertion is reported as a substitution.
+func diffWords(oldText, newText string) []diffOp {
+	a, b := strings.Fields(oldText), strings.Fields(newText)
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var ops []diffOp
+	add := func(kind diffKind, oldWord, newWord string) {
+		if n := len(ops); n == 0 || ops[n-1].kind != kind {
+			ops = append(ops, diffOp{kind: kind})
+		}
+		op := &ops[len(ops)-1]
+		if oldWord != "" {
+			op.old = append(op.old, oldWord)
+		}
+		if newWord != "" {
+			op.new = append(op.new, newWord)
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			add(diffEqual, a[i], b[j])
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			add(diffDelete, a[i], "")
+			i++
+		default:
+			add(diffInsert, "", b[j])
+			j++
+		}
+	}
+
+	return mergeSubstitutions(ops)
+}
+
+func mergeSubstitutions(ops []diffOp) []diffOp {
+	merged := ops[:0]
+	for i := 0; i < len(ops); i++ {
+		op := ops[i]
+		if i+1 < len(ops) && op.kind == diffDelete && ops[i+1].kind == diffInsert {
+			op = diffOp{kind: diffSubstitute, old: op.old, new: ops[i+1].new}
+			i++
+		}
+		merged = append(merged, op)
+	}
+	return merged
+}
+
+// diffVerses compares verses of translation from with the same passages
+// in translation to, mapping each reference between the translations'
+// verse numbering.
+func (mbd *MultiBibleData) diffVerses(from, to string, verses []Verse) ([]verseDiff, error) {
+	bibleData, err := mbd.LoadTranslation(to)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := make([]verseDiff, len(verses))
+	for i, verse := range verses {
+		ref := verseKey{book: verse.Book, chapter: verse.Chapter, verse: verse.Verse}
+		target := mbd.MapVerse(ref, from, to)
+		target.book = bibleData.translationBook(target.book)
+		other, ok := bibleData.lookupVerse(target)
+		diffs[i] = verseDiff{
+			ref:     ref,
+			target:  target,
+			ops:     diffWords(verse.Text, other.Text),
+			missing: !ok,
+		}
+	}
+	return diffs, nil
+}
+
+// Exit codes of the diff subcommand, following diff(1).
+const (
+	diffSame    = 0
+	diffChanged = 1
+	diffTrouble = 2
+)
+
+// runDiff implements "bible-go diff [-unified] FROM TO REFERENCE", printing
+// the verses of REFERENCE that differ between the two translations.
+func runDiff(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	unified := flags.Bool("unified", false, "print removed and added verses as in diff -u")
+	flags.BoolVar(unified, "u", false, "shorthand for -unified")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: bible-go diff [-unified] FROM TO REFERENCE\n\n")
+		fmt.Fprintf(stderr, "Shows the word-level differences between two translations, e.g.\n")
+		fmt.Fprintf(stderr, "bible-go diff KJV ESV John 3\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return diffTrouble
+	}
+	if flags.NArg() < 3 {
+		flags.Usage()
+		return diffTrouble
+	}
+	from, to := flags.Arg(0), flags.Arg(1)
+	query := strings.Join(flags.Args()[2:], " ")
+
+	mbd, err := NewMultiBibleData()
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return diffTrouble
+	}
+	// Line verses up the way the compare view does, with the configured
+	// versifications.
+	config, _ := loadConfig()
+	if err := mbd.SetVersifications(config.Versification); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return diffTrouble
+	}
+	for _, name := range []string{from, to} {
+		if !contains(mbd.translationNames, name) {
+			fmt.Fprintf(stderr, "Error: unknown translation %q (available: %s)\n", name, strings.Join(mbd.translationNames, ", "))
+			return diffTrouble
+		}
+	}
+
+	bibleData, err := mbd.LoadTranslation(from)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return diffTrouble
+	}
+	ranges, ok := bibleData.parseReference(query)
+	if !ok {
+		fmt.Fprintf(stderr, "Error: %q is not a reference in %s\n", query, from)
+		return diffTrouble
+	}
+	verses := bibleData.versesInRanges(ranges)
+	if len(verses) == 0 {
+		fmt.Fprintf(stderr, "Error: %s has no verses for %q\n", from, query)
+		return diffTrouble
+	}
+
+	diffs, err := mbd.diffVerses(from, to, verses)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return diffTrouble
+	}
+
+	exitCode := diffSame
+	for _, d := range diffs {
+		if !d.changed() {
+			continue
+		}
+		if *unified && exitCode == diffSame {
+			fmt.Fprintf(stdout, "--- %s %s\n+++ %s %s\n", from, query, to, query)
+		}
+		exitCode = diffChanged
+		if *unified {
+			writeUnifiedVerseDiff(stdout, d)
+		} else {
+			writePlainVerseDiff(stdout, d)
+		}
+	}
+	return exitCode
+}
+
+// writePlainVerseDiff prints a verse with changes marked inline in the
+// style of git's word diff: [-removed-]{+added+}.
+func writePlainVerseDiff(w io.Writer, d verseDiff) {
+	var parts []string
+	for _, op := range d.ops {
+		switch op.kind {
+		case diffEqual:
+			parts = append(parts, op.old...)
+		case diffDelete:
+			parts = append(parts, "[-"+strings.Join(op.old, " ")+"-]")
+		case diffInsert:
+			parts = append(parts, "{+"+strings.Join(op.new, " ")+"+}")
+		case diffSubstitute:
+			parts = append(parts, "[-"+strings.Join(op.old, " ")+"-]{+"+strings.Join(op.new, " ")+"+}")
+		}
+	}
+	fmt.Fprintf(w, "%s %d:%d %s\n", d.ref.book, d.ref.chapter, d.ref.verse, strings.Join(parts, " "))
+}
+
+func writeUnifiedVerseDiff(w io.Writer, d verseDiff) {
+	var oldWords, newWords []string
+	for _, op := range d.ops {
+		oldWords = append(oldWords, op.old...)
+		newWords = append(newWords, op.new...)
+	}
+	fmt.Fprintf(w, "@@ %s %d:%d @@\n", d.ref.book, d.ref.chapter, d.ref.verse)
+	fmt.Fprintf(w, "-%s\n", strings.Join(oldWords, " "))
+	if !d.missing {
+		fmt.Fprintf(w, "+%s\n", strings.Join(newWords, " "))
+	}
+}
+
+var (
+	diffDeleteStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#f38ba8")).
+			Strikethrough(true)
+
+	diffInsertStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#a6e3a1")).
+			Underline(true)
+
+	diffSubstituteOldStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#fab387")).
+				Strikethrough(true)
+
+	diffSubstituteNewStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#f9e2af")).
+				Underline(true)
+)
+
+type styledWord struct {
+	text  string
+	style lipgloss.Style
+}
+
+// compareTranslation is the translation the current one is compared
+// with: the second column of the parallel view.
+func (m model) compareTranslation() string {
+	if columns := m.parallelColumns(); len(columns) > 1 {
+		return columns[1]
+	}
+	return ""
+}
+
+// compareWords returns the words of a verse in compare mode, styled by
+// how they differ in the compared translation.
+func (m model) compareWords(verse Verse) []styledWord {
+	plain := func(text string, style lipgloss.Style) []styledWord {
+		var words []styledWord
+		for _, word := range strings.Fields(text) {
+			words = append(words, styledWord{word, style})
+		}
+		return words
+	}
+
+	other := m.compareTranslation()
+	switch {
+	case other == "":
+		return plain(verse.Text, m.textStyle)
+	case m.multiBibleData.LoadError(other) != nil, !m.multiBibleData.IsLoaded(other):
+		return plain(verse.Text, m.textStyle)
+	}
+
+	diffs, err := m.multiBibleData.diffVerses(m.currentTranslation, other, []Verse{verse})
+	if err != nil {
+		return plain(verse.Text, m.textStyle)
+	}
+	if diffs[0].missing {
+		return append(plain(verse.Text, diffDeleteStyle), styledWord{"(not in " + other + ")", m.dimStyle})
+	}
+
+	var words []styledWord
+	for _, op := range diffs[0].ops {
+		switch op.kind {
+		case diffEqual:
+			words = append(words, plain(strings.Join(op.old, " "), m.textStyle)...)
+		case diffDelete:
+			words = append(words, plain(strings.Join(op.old, " "), diffDeleteStyle)...)
+		case diffInsert:
+			words = append(words, plain(strings.Join(op.new, " "), diffInsertStyle)...)
+		case diffSubstitute:
+			words = append(words, plain(strings.Join(op.old, " "), diffSubstituteOldStyle)...)
+			words = append(words, plain(strings.Join(op.new, " "), diffSubstituteNewStyle)...)
+		}
+	}
+	return words
+}
+
+// wrapStyledWords wraps words like wrapVerseText, measuring the plain
+// text so styling does not affect the line breaks.
+func wrapStyledWords(words []styledWord, maxWidth int) []string {
+	var lines []string
+	var line strings.Builder
+	width := 0
+	for _, word := range words {
+		if width > 0 && width+1+lipgloss.Width(word.text) > maxWidth {
+			lines = append(lines, line.String())
+			line.Reset()
+			width = 0
+		}
+		if width > 0 {
+			line.WriteByte(' ')
+			width++
+		}
+		line.WriteString(word.style.Render(word.text))
+		width += lipgloss.Width(word.text)
+	}
+	if width > 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+func (m model) compareVerseHeight(verse Verse) int {
+	textWidth := max(20, m.width-verseTextPadding)
+	return max(1, len(wrapStyledWords(m.compareWords(verse), textWidth))) + 1
+}
+
+// renderCompareVerse renders a verse with its differences from the
+// compared translation highlighted and returns the number of lines used.
+func (m model) renderCompareVerse(content *strings.Builder, verse Verse, isSelected bool, verseNumStr string) int {
+	if isSelected {
+		cursorStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(m.config.HighlightColor)).
+			Bold(true)
+		content.WriteString(cursorStyle.Render(">"))
+	} else {
+		content.WriteString(" ")
+	}
+	content.WriteByte(' ')
+	content.WriteString(verseNumStr)
+	content.WriteByte(' ')
+
+	textWidth := max(20, m.width-verseTextPadding)
+	lines := wrapStyledWords(m.compareWords(verse), textWidth)
+	padding := strings.Repeat(" ", verseTextPadding)
+	for i, line := range lines {
+		if i > 0 {
+			content.WriteString(padding)
+		}
+		content.WriteString(line)
+		content.WriteByte('\n')
+	}
+	if len(lines) == 0 {
+		content.WriteByte('\n')
+	}
+
+	content.WriteByte('\n')
+	return max(1, len(lines)) + 1
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		want     []diffOp
+		changed  bool
+	}{
+		{
+			name: "identical",
+			old:  "For God so loved",
+			new:  "For  God so\tloved",
+			want: []diffOp{{diffEqual, []string{"For", "God", "so", "loved"}, []string{"For", "God", "so", "loved"}}},
+		},
+		{
+			name: "substitution",
+			old:  "In the beginning God created",
+			new:  "In the beginning was the Word",
+			want: []diffOp{
+				{diffEqual, []string{"In", "the", "beginning"}, []string{"In", "the", "beginning"}},
+				{diffSubstitute, []string{"God", "created"}, []string{"was", "the", "Word"}},
+			},
+			changed: true,
+		},
+		{
+			name: "word replaced in the middle",
+			old:  "the LORD is my shepherd",
+			new:  "the Lord is my shepherd",
+			want: []diffOp{
+				{diffEqual, []string{"the"}, []string{"the"}},
+				{diffSubstitute, []string{"LORD"}, []string{"Lord"}},
+				{diffEqual, []string{"is", "my", "shepherd"}, []string{"is", "my", "shepherd"}},
+			},
+			changed: true,
+		},
+		{
+			name: "deletion",
+			old:  "Jesus wept bitterly",
+			new:  "Jesus bitterly",
+			want: []diffOp{
+				{diffEqual, []string{"Jesus"}, []string{"Jesus"}},
+				{diffDelete, []string{"wept"}, nil},
+				{diffEqual, []string{"bitterly"}, []string{"bitterly"}},
+			},
+			changed: true,
+		},
+		{
+			name: "insertion at the end",
+			old:  "Jesus wept",
+			new:  "Jesus wept aloud",
+			want: []diffOp{
+				{diffEqual, []string{"Jesus", "wept"}, []string{"Jesus", "wept"}},
+				{diffInsert, nil, []string{"aloud"}},
+			},
+			changed: true,
+		},
+		{
+			name:    "empty old text",
+			old:     "",
+			new:     "Jesus wept",
+			want:    []diffOp{{diffInsert, nil, []string{"Jesus", "wept"}}},
+			changed: true,
+		},
+		{
+			name: "both empty",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffWords(tt.old, tt.new)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffWords(%q, %q) = %v, want %v", tt.old, tt.new, got, tt.want)
+			}
+			if changed := (verseDiff{ops: got}).changed(); changed != tt.changed {
+				t.Errorf("changed() = %v, want %v", changed, tt.changed)
+			}
+		})
+	}
+}
+
+func TestRunDiffVersification(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		want   int
+	}{
+		{name: "detected", want: diffChanged},
+		{name: "configured", config: `{"versification": {"HEB": "mt"}}`, want: diffSame},
+		{name: "unknown scheme", config: `{"versification": {"HEB": "nrsv"}}`, want: diffTrouble},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configHome := t.TempDir()
+			t.Setenv("XDG_CONFIG_HOME", configHome)
+			t.Setenv("XDG_CACHE_HOME", t.TempDir())
+			translations := filepath.Join(configHome, "bible-go", "translations")
+			if err := os.MkdirAll(translations, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			// HEB follows the Hebrew numbering but has too little of
+			// Malachi 3 for it to be detected.
+			files := map[string]string{
+				"ENG_bible.json": `{"Malachi": {"4": {"1": "For, behold, the day cometh"}}}`,
+				"HEB_bible.json": `{"Malachi": {"3": {"19": "For, behold, the day cometh"}}}`,
+			}
+			for name, content := range files {
+				if err := os.WriteFile(filepath.Join(translations, name), []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.config != "" {
+				if err := os.WriteFile(filepath.Join(configHome, "bible-go", "config.json"), []byte(tt.config), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var stdout, stderr strings.Builder
+			if got := runDiff([]string{"ENG", "HEB", "Mal 4:1"}, &stdout, &stderr); got != tt.want {
+				t.Errorf("runDiff() = %d, want %d\nstdout: %s\nstderr: %s", got, tt.want, stdout.String(), stderr.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// TranslationLoadError reports which translation failed to load. Err is
+// one of the more specific errors below or an error from the parser.
+type TranslationLoadError struct {
+	Translation string
+	Path        string
+	Err         error
+}
+
+func (e *TranslationLoadError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("translation %s: %v", e.Translation, e.Err)
+	}
+	return fmt.Sprintf("translation %s (%s): %v", e.Translation, e.Path, e.Err)
+}
+
+func (e *TranslationLoadError) Unwrap() error { return e.Err }
+
+// ReadError reports a translation source that could not be read.
+type ReadError struct {
+	Path string
+	Err  error
+}
+
+func (e *ReadError) Error() string {
+	return fmt.Sprintf("cannot read %s: %v", e.Path, e.Err)
+}
+
+func (e *ReadError) Unwrap() error { return e.Err }
+
+// SyntaxError reports malformed JSON or XML with the 1-based position of
+// the problem.
+type SyntaxError struct {
+	Format string
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s syntax error at line %d, column %d: %v", e.Format, e.Line, e.Column, e.Err)
+}
+
+func (e *SyntaxError) Unwrap() error { return e.Err }
+
+// EmptyBookError reports a book without any verses.
+type EmptyBookError struct {
+	Book string
+}
+
+func (e *EmptyBookError) Error() string {
+	return fmt.Sprintf("book %q has no verses", e.Book)
+}
+
+// ChapterKeyError reports a chapter key that is not a number.
+type ChapterKeyError struct {
+	Book string
+	Key  string
+}
+
+func (e *ChapterKeyError) Error() string {
+	return fmt.Sprintf("book %q has non-numeric chapter key %q", e.Book, e.Key)
+}
+
+// jsonSyntaxError converts the byte offsets reported by encoding/json,
+// which count the character at fault, into a SyntaxError with the line
+// and column of that character. Other errors are returned as is.
+func jsonSyntaxError(data []byte, err error) error {
+	var offset int64
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	default:
+		return err
+	}
+
+	line, column := lineAndColumn(data, offset-1)
+	return &SyntaxError{Format: "JSON", Line: line, Column: column, Err: err}
+}
+
+func lineAndColumn(data []byte, offset int64) (int, int) {
+	offset = min(max(offset, 0), int64(len(data)))
+	before := data[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := int(offset) - bytes.LastIndexByte(before, '\n')
+	return line, column
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type translationFormat int
+
+const (
+	formatUnknown translationFormat = iota
+	formatJSON
+	formatOSIS
+	formatZefania
+	formatTSV
+	formatCSV
+	formatUSFMDir
+	formatUSFMZip
+)
+
+func (f translationFormat) String() string {
+	switch f {
+	case formatJSON:
+		return "JSON"
+	case formatOSIS:
+		return "OSIS"
+	case formatZefania:
+		return "Zefania"
+	case formatTSV:
+		return "TSV"
+	case formatCSV:
+		return "CSV"
+	case formatUSFMDir, formatUSFMZip:
+		return "USFM"
+	default:
+		return "unknown"
+	}
+}
+
+// detectTranslationFormat recognises a translation source by its
+// extension and, for XML and plain text files, by its content.
+func detectTranslationFormat(path string) translationFormat {
+	info, err := os.Stat(path)
+	if err != nil {
+		return formatUnknown
+	}
+	if info.IsDir() {
+		if dirHasUSFM(path) {
+			return formatUSFMDir
+		}
+		return formatUnknown
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		if looksLikeJSONBible(path) {
+			return formatJSON
+		}
+	case ".xml":
+		head := readFileHead(path)
+		switch {
+		case bytes.Contains(head, []byte("<osis")):
+			return formatOSIS
+		case bytes.Contains(bytes.ToUpper(head), []byte("<XMLBIBLE")):
+			return formatZefania
+		}
+	case ".tsv":
+		return formatTSV
+	case ".csv":
+		return formatCSV
+	case ".txt":
+		firstLine, _, _ := bytes.Cut(readFileHead(path), []byte("\n"))
+		if bytes.Count(firstLine, []byte("\t")) >= 3 {
+			return formatTSV
+		}
+	case ".zip":
+		if zipHasUSFM(path) {
+			return formatUSFMZip
+		}
+	}
+
+	return formatUnknown
+}
+
+// looksLikeJSONBible reports whether a JSON file starts like a
+// translation: an object of books holding objects of chapters, so other
+// JSON files, such as a config or bookmarks file, are not taken for one.
+func looksLikeJSONBible(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	decoder := json.NewDecoder(f)
+	var tokens []json.Token
+	for len(tokens) < 5 {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		tokens = append(tokens, token)
+	}
+
+	isDelim := func(i int, delim json.Delim) bool {
+		return i < len(tokens) && tokens[i] == delim
+	}
+	isKey := func(i int) bool {
+		if i >= len(tokens) {
+			return false
+		}
+		_, ok := tokens[i].(string)
+		return ok
+	}
+	// {"Genesis": {"1": {...}}} or a book without chapters, {"Genesis": {}}.
+	return isDelim(0, '{') && isKey(1) && isDelim(2, '{') &&
+		(isDelim(3, '}') || isKey(3) && isDelim(4, '{'))
+}
+
+func readFileHead(path string) []byte {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	head := make([]byte, 4096)
+	n, _ := io.ReadFull(f, head)
+	return head[:n]
+}
+
+// translationName derives a translation name from a file name by
+// dropping the extension and the conventional suffixes, so
+// "ESV_bible.json", "KJV.osis.xml" and "WEB_usfm.zip" become "ESV",
+// "KJV" and "WEB".
+func translationName(fileName string) string {
+	name := strings.TrimSuffix(fileName, filepath.Ext(fileName))
+	for _, suffix := range []string{".osis", ".zefania", "_bible", "_usfm"} {
+		name = strings.TrimSuffix(name, suffix)
+	}
+	return name
+}
+
+// loadTranslationFile parses a translation source in whichever format
+// detectTranslationFormat reports for it.
+func loadTranslationFile(path string) (*BibleData, error) {
+	format := detectTranslationFormat(path)
+
+	switch format {
+	case formatUSFMDir:
+		return loadUSFMDir(path)
+	case formatUSFMZip:
+		return loadUSFMZip(path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, &ReadError{Path: path, Err: err}
+	}
+
+	switch format {
+	case formatOSIS:
+		return NewBibleDataFromOSIS(data)
+	case formatZefania:
+		return NewBibleDataFromZefania(data)
+	case formatTSV:
+		return NewBibleDataFromDelimited(data, '\t')
+	case formatCSV:
+		return NewBibleDataFromDelimited(data, ',')
+	default:
+		return NewBibleData(data)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDetectTranslationFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    translationFormat
+	}{
+		{"KJV_bible.json", `{"Genesis": {"1": {"1": "In the beginning"}}}`, formatJSON},
+		{"broken_bible.json", `{"Genesis": {"1": {"1": "In the beginning",}}}`, formatJSON},
+		{"empty_book.json", `{"Genesis": {}}`, formatJSON},
+		{"config.json", `{"highlightColor": "#cba6f7", "prewarmTranslations": true}`, formatUnknown},
+		{"state.json", `{"currentTranslation": "KJV", "marks": {"KJV": {"a": {"book": "John"}}}}`, formatUnknown},
+		{"bookmarks.json", `[{"book": "John", "chapter": 3, "verse": 16}]`, formatUnknown},
+		{"empty.json", ``, formatUnknown},
+		{"KJV.osis.xml", `<?xml version="1.0"?><osis><osisText/></osis>`, formatOSIS},
+		{"ZEF.xml", `<?xml version="1.0"?><XMLBIBLE biblename="KJV"/>`, formatZefania},
+		{"other.xml", `<?xml version="1.0"?><html/>`, formatUnknown},
+		{"WEB.tsv", "Genesis\t1\t1\tIn the beginning\n", formatTSV},
+		{"WEB.csv", "Genesis,1,1,In the beginning\n", formatCSV},
+		{"WEB.txt", "Genesis\t1\t1\tIn the beginning\n", formatTSV},
+		{"notes.txt", "Some notes\n", formatUnknown},
+	}
+	dir := t.TempDir()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.name)
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if got := detectTranslationFormat(path); got != tt.want {
+				t.Errorf("detectTranslationFormat(%s) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslationName(t *testing.T) {
+	tests := []struct {
+		fileName string
+		want     string
+	}{
+		{"ESV_bible.json", "ESV"},
+		{"KJV.osis.xml", "KJV"},
+		{"LUT.zefania.xml", "LUT"},
+		{"WEB_usfm.zip", "WEB"},
+		{"WEB", "WEB"},
+		{"NET.tsv", "NET"},
+	}
+	for _, tt := range tests {
+		if got := translationName(tt.fileName); got != tt.want {
+			t.Errorf("translationName(%q) = %q, want %q", tt.fileName, got, tt.want)
+		}
+	}
+}
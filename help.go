@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// The help line only shows the core keys so it fits on one line; ? opens
+// a screen listing every key, grouped as in the README.
+
+type helpSection struct {
+	title string
+	keys  [][2]string
+}
+
+var helpSections = []helpSection{
+	{"Reading", [][2]string{
+		{"j/k ↑/↓", "Previous/next verse"},
+		{"h/l ←/→", "Previous/next chapter"},
+		{"b/w", "Previous/next book"},
+		{"g/G", "First/last verse"},
+		{"{n}G", "Verse n of the chapter"},
+		{"Ctrl+d/u", "Half page down/up"},
+		{"t/T", "Next/previous translation"},
+		{"o", "Book picker"},
+	}},
+	{"Views", [][2]string{
+		{"z", "Zen mode"},
+		{"p", "Parallel view"},
+		{"c", "Compare translations"},
+		{"N", "Notes pane"},
+	}},
+	{"Verses", [][2]string{
+		{"v/V", "Visual selection"},
+		{"y", "Copy as a citation"},
+		{"H", "Cycle highlight color"},
+		{"n", "Edit note"},
+		{"B", "Toggle bookmark"},
+	}},
+	{"Jumping", [][2]string{
+		{"/", "Search"},
+		{"L", "Bookmarks"},
+		{"m{a-z}", "Set mark"},
+		{"'{a-z}", "Jump to mark"},
+		{"Ctrl+o/Tab", "Jump back/forward"},
+	}},
+	{"General", [][2]string{
+		{":", "Command line"},
+		{"?", "This help"},
+		{"q/Esc", "Quit"},
+	}},
+}
+
+func (m *model) openHelp() {
+	m.mode = helpMode
+}
+
+// handleHelpKey closes the help screen on ?, q or Esc.
+func (m *model) handleHelpKey(msg tea.KeyMsg) {
+	switch msg.String() {
+	case "?", "q", "esc", "ctrl+c":
+		m.mode = navigationMode
+	}
+}
+
+// renderHelp renders the key list below the header, in as many columns as
+// the width allows, and returns the number of lines used. Lines that do
+// not fit the height are cut.
+func (m model) renderHelp(content *strings.Builder) int {
+	keyWidth := 0
+	for _, section := range helpSections {
+		for _, key := range section.keys {
+			keyWidth = max(keyWidth, lipgloss.Width(key[0]))
+		}
+	}
+
+	var blocks [][]string
+	blockWidth := 0
+	for _, section := range helpSections {
+		block := []string{m.bookStyle.Render(section.title)}
+		for _, key := range section.keys {
+			line := m.verseNumStyle.Render(fmt.Sprintf("%-*s", keyWidth, key[0])) + "  " + key[1]
+			block = append(block, line)
+			blockWidth = max(blockWidth, lipgloss.Width(line))
+		}
+		blocks = append(blocks, block)
+	}
+
+	// Spread whole sections evenly over as many columns as fit.
+	const columnGap = 4
+	available := max(1, m.height-4)
+	columnCount := max(1, (m.width+columnGap)/(blockWidth+columnGap))
+	totalLines := len(blocks) - 1
+	for _, block := range blocks {
+		totalLines += len(block)
+	}
+	columnHeight := min(available, (totalLines+columnCount-1)/columnCount)
+
+	var columns [][]string
+	for _, block := range blocks {
+		n := len(columns)
+		if n > 0 && (len(columns[n-1])+1+len(block) <= columnHeight || n == columnCount) {
+			columns[n-1] = append(append(columns[n-1], ""), block...)
+			continue
+		}
+		columns = append(columns, block)
+	}
+
+	rendered := make([]string, len(columns))
+	for i, column := range columns {
+		style := lipgloss.NewStyle().Width(blockWidth)
+		if i < len(columns)-1 {
+			style = style.MarginRight(columnGap)
+		}
+		rendered[i] = style.Render(strings.Join(column, "\n"))
+	}
+	lines := strings.Split(lipgloss.JoinHorizontal(lipgloss.Top, rendered...), "\n")
+	lines = lines[:min(len(lines), available)]
+
+	padding := strings.Repeat(" ", max(0, (m.width-lipgloss.Width(strings.Join(lines, "\n")))/2))
+	for _, line := range lines {
+		content.WriteString(padding + line + "\n")
+	}
+	return len(lines)
+}
+
+// fitHelpText drops keys from the end of helpText until it fits in width,
+// so the help line never wraps.
+func fitHelpText(helpText string, width int) string {
+	items := strings.Split(helpText, " • ")
+	for len(items) > 1 && lipgloss.Width(strings.Join(items, " • ")) > width {
+		items = items[:len(items)-1]
+	}
+	return strings.Join(items, " • ")
+}
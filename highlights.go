@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// HighlightColor is one of the colors verses can be highlighted with.
+// Highlights are stored by Name, so the color can be changed later.
+type HighlightColor struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+func defaultHighlightPalette() []HighlightColor {
+	return []HighlightColor{
+		{Name: "promise", Color: "#a6e3a1"},
+		{Name: "command", Color: "#89b4fa"},
+		{Name: "warning", Color: "#f38ba8"},
+	}
+}
+
+func saveHighlights(highlights map[string]string) error {
+	return saveJSON(highlightsFile, highlights)
+}
+
+func loadHighlights() map[string]string {
+	highlights := make(map[string]string)
+	if err := loadJSON(highlightsFile, &highlights); err != nil || highlights == nil {
+		return make(map[string]string)
+	}
+	return highlights
+}
+
+// canonicalKey identifies the passage a verse of translation shows
+// independently of the translation: the standard book name with KJV
+// chapter and verse numbers. Psalm titles numbered as verses are kept
+// apart from verse 1 as verse 0 and below.
+func (mbd *MultiBibleData) canonicalKey(translation string, verse Verse) verseKey {
+	book := canonicalBook(verse.Book)
+	if book == "" {
+		book = verse.Book
+	}
+	chapter, number := mbd.Versification(translation).toKJVKey(book, verse.Chapter, verse.Verse)
+	return verseKey{book: book, chapter: chapter, verse: number}
+}
+
+// CanonicalReference formats the canonical key of a verse, e.g.
+// "Psalm 51:1".
+func (mbd *MultiBibleData) CanonicalReference(translation string, verse Verse) string {
+	key := mbd.canonicalKey(translation, verse)
+	return fmt.Sprintf("%s %d:%d", key.book, key.chapter, key.verse)
+}
+
+// highlightOf returns the highlight color of a verse of the current
+// translation, if it has one.
+func (m model) highlightOf(verse Verse) (HighlightColor, bool) {
+	name, ok := m.highlights[m.multiBibleData.CanonicalReference(m.currentTranslation, verse)]
+	if !ok {
+		return HighlightColor{}, false
+	}
+	for _, color := range m.config.HighlightPalette {
+		if color.Name == name {
+			return color, true
+		}
+	}
+	return HighlightColor{}, false
+}
+
+// cycleHighlight moves verses on to the next color of the palette after
+// the color of the first verse, and from the last color back to none.
+func (m *model) cycleHighlight(verses []Verse) {
+	palette := m.config.HighlightPalette
+	if len(verses) == 0 || len(palette) == 0 {
+		return
+	}
+
+	next := 0
+	if current, ok := m.highlightOf(verses[0]); ok {
+		for i, color := range palette {
+			if color.Name == current.Name {
+				next = i + 1
+			}
+		}
+	}
+
+	for _, verse := range verses {
+		reference := m.multiBibleData.CanonicalReference(m.currentTranslation, verse)
+		if next < len(palette) {
+			m.highlights[reference] = palette[next].Name
+		} else {
+			delete(m.highlights, reference)
+		}
+	}
+
+	target := fmt.Sprintf("%s %d:%d", verses[0].Book, verses[0].Chapter, verses[0].Verse)
+	if len(verses) > 1 {
+		target = fmt.Sprintf("%d verses", len(verses))
+	}
+	if next < len(palette) {
+		m.statusMessage = fmt.Sprintf("Highlighted %s as %s", target, palette[next].Name)
+	} else {
+		m.statusMessage = fmt.Sprintf("Removed highlight from %s", target)
+	}
+
+	if err := saveHighlights(m.highlights); err != nil {
+		m.statusMessage = fmt.Sprintf("Failed to save highlights: %v", err)
+	}
+}
+
+// verseTextStyle is the style of a verse's text: the highlight color as
+// background for highlighted verses, the plain text style otherwise.
+func (m model) verseTextStyle(verse Verse) lipgloss.Style {
+	if color, ok := m.highlightOf(verse); ok {
+		return lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#1e1e2e")).
+			Background(lipgloss.Color(color.Color))
+	}
+	return m.textStyle
+}
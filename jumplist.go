@@ -0,0 +1,73 @@
+package main
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// The jump list records where the reader was before each non-linear jump
+// (a search result, :goto, a bookmark, the picker), so Ctrl+o can go
+// back and Ctrl+i forward again, like vim's jump list. It is kept in the
+// state file so it survives restarts.
+
+const maxJumps = 100
+
+// Position is a place in the jump list.
+type Position struct {
+	Translation string `json:"translation"`
+	Book        string `json:"book"`
+	Chapter     int    `json:"chapter"`
+	Verse       int    `json:"verse"`
+}
+
+func (p Position) key() verseKey {
+	return verseKey{book: p.Book, chapter: p.Chapter, verse: p.Verse}
+}
+
+func (m model) currentPosition() Position {
+	key := m.currentVerseKey()
+	return Position{Translation: m.currentTranslation, Book: key.book, Chapter: key.chapter, Verse: key.verse}
+}
+
+// recordJump adds the current position to the jump list before a jump.
+// Positions after the one reached with Ctrl+o are dropped, as in a
+// browser's history.
+func (m *model) recordJump() {
+	jumps := m.jumps[:min(m.jumpIndex, len(m.jumps))]
+	current := m.currentPosition()
+	if len(jumps) == 0 || jumps[len(jumps)-1] != current {
+		jumps = append(jumps, current)
+	}
+	if len(jumps) > maxJumps {
+		jumps = jumps[len(jumps)-maxJumps:]
+	}
+	m.jumps = jumps
+	m.jumpIndex = len(jumps)
+}
+
+// jumpBack goes to the previous position in the jump list. Leaving the
+// end of the list records the current position first, so jumpForward can
+// return to it.
+func (m *model) jumpBack() tea.Cmd {
+	if m.jumpIndex >= len(m.jumps) {
+		m.recordJump()
+		m.jumpIndex = len(m.jumps) - 1
+	}
+	if m.jumpIndex <= 0 {
+		m.statusMessage = "Already at the oldest position"
+		return nil
+	}
+	m.jumpIndex--
+	return m.goToJump()
+}
+
+func (m *model) jumpForward() tea.Cmd {
+	if m.jumpIndex >= len(m.jumps)-1 {
+		m.statusMessage = "Already at the newest position"
+		return nil
+	}
+	m.jumpIndex++
+	return m.goToJump()
+}
+
+func (m *model) goToJump() tea.Cmd {
+	position := m.jumps[m.jumpIndex]
+	return m.jumpTo(position.Translation, position.key())
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func testPosition(book string, chapter, verse int) Position {
+	return Position{Translation: "TST", Book: book, Chapter: chapter, Verse: verse}
+}
+
+func TestRecordJump(t *testing.T) {
+	gen1 := testPosition("Genesis", 1, 1)
+	gen2 := testPosition("Genesis", 2, 1)
+	john := testPosition("John", 3, 16)
+
+	full := make([]Position, maxJumps)
+	for i := range full {
+		full[i] = testPosition("John", 3, i+100)
+	}
+
+	tests := []struct {
+		name      string
+		jumps     []Position
+		jumpIndex int
+		want      []Position
+	}{
+		{name: "empty", want: []Position{gen1}},
+		{name: "appends", jumps: []Position{john, gen2}, jumpIndex: 2, want: []Position{john, gen2, gen1}},
+		{name: "skips a repeat", jumps: []Position{john, gen1}, jumpIndex: 2, want: []Position{john, gen1}},
+		{name: "drops newer positions", jumps: []Position{john, gen2, john}, jumpIndex: 1, want: []Position{john, gen1}},
+		{name: "index past the end", jumps: []Position{john}, jumpIndex: 5, want: []Position{john, gen1}},
+		{name: "capped", jumps: full, jumpIndex: maxJumps, want: append(slices.Clone(full[1:]), gen1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel(t)
+			m.jumps = slices.Clone(tt.jumps)
+			m.jumpIndex = tt.jumpIndex
+			m.recordJump()
+			if !slices.Equal(m.jumps, tt.want) {
+				t.Errorf("jumps = %v, want %v", m.jumps, tt.want)
+			}
+			if m.jumpIndex != len(tt.want) {
+				t.Errorf("jumpIndex = %d, want %d", m.jumpIndex, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestJumpBackForward(t *testing.T) {
+	m := newTestModel(t)
+	m.recordJump()
+	m.jumpTo("TST", verseKey{"John", 3, 16})
+	m.recordJump()
+	m.jumpTo("TST", verseKey{"Genesis", 2, 1})
+
+	steps := []struct {
+		name       string
+		step       func(m *model)
+		want       Position
+		wantStatus string
+	}{
+		{name: "back", step: func(m *model) { m.jumpBack() }, want: testPosition("John", 3, 16)},
+		{name: "back again", step: func(m *model) { m.jumpBack() }, want: testPosition("Genesis", 1, 1)},
+		{name: "back at the oldest", step: func(m *model) { m.jumpBack() }, want: testPosition("Genesis", 1, 1), wantStatus: "Already at the oldest position"},
+		{name: "forward", step: func(m *model) { m.jumpForward() }, want: testPosition("John", 3, 16)},
+		{name: "forward to where back started", step: func(m *model) { m.jumpForward() }, want: testPosition("Genesis", 2, 1)},
+		{name: "forward at the newest", step: func(m *model) { m.jumpForward() }, want: testPosition("Genesis", 2, 1), wantStatus: "Already at the newest position"},
+		{name: "back before a new jump", step: func(m *model) { m.jumpBack() }, want: testPosition("John", 3, 16)},
+		{name: "new jump", step: func(m *model) {
+			m.recordJump()
+			m.jumpTo("TST", verseKey{"Genesis", 1, 5})
+		}, want: testPosition("Genesis", 1, 5)},
+		{name: "forward after a new jump", step: func(m *model) { m.jumpForward() }, want: testPosition("Genesis", 1, 5), wantStatus: "Already at the newest position"},
+		{name: "back after a new jump", step: func(m *model) { m.jumpBack() }, want: testPosition("John", 3, 16)},
+	}
+	for _, tt := range steps {
+		m.statusMessage = ""
+		tt.step(&m)
+		if got := m.currentPosition(); got != tt.want || m.statusMessage != tt.wantStatus {
+			t.Fatalf("%s: at %v with status %q, want %v with status %q", tt.name, got, m.statusMessage, tt.want, tt.wantStatus)
+		}
+	}
+	want := []Position{testPosition("Genesis", 1, 1), testPosition("John", 3, 16), testPosition("Genesis", 1, 5)}
+	if !slices.Equal(m.jumps, want) {
+		t.Errorf("jumps = %v, want %v", m.jumps, want)
+	}
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Marks work like vim's: m{a-z} marks the selected verse and '{a-z}
+// jumps back to it. Lowercase marks belong to the translation they were
+// set in, like vim's marks in a file; uppercase marks are global and
+// switch to their translation. Both are kept in the state file.
+
+// markEntry is a row of the marks screen.
+type markEntry struct {
+	name     rune
+	position Position
+}
+
+// startMark waits for the letter after m or '.
+func (m *model) startMark(r rune) {
+	m.pendingMark = r
+	m.statusMessage = string(r)
+}
+
+// handleMarkKey sets or jumps to the mark named by the key after m or '.
+// Any other key cancels.
+func (m *model) handleMarkKey(msg tea.KeyMsg) tea.Cmd {
+	action := m.pendingMark
+	m.pendingMark = 0
+	if msg.Type != tea.KeyRunes || len(msg.Runes) != 1 || !isMarkName(msg.Runes[0]) {
+		return nil
+	}
+	name := msg.Runes[0]
+	if action == 'm' {
+		m.setMark(name)
+		return nil
+	}
+	return m.jumpToMark(name)
+}
+
+func isMarkName(r rune) bool {
+	return r < unicode.MaxASCII && unicode.IsLetter(r)
+}
+
+func (m *model) setMark(name rune) {
+	if len(m.verses) == 0 {
+		return
+	}
+	position := m.currentPosition()
+	if unicode.IsUpper(name) {
+		if m.globalMarks == nil {
+			m.globalMarks = make(map[string]Position)
+		}
+		m.globalMarks[string(name)] = position
+	} else {
+		if m.marks == nil {
+			m.marks = make(map[string]map[string]Position)
+		}
+		if m.marks[m.currentTranslation] == nil {
+			m.marks[m.currentTranslation] = make(map[string]Position)
+		}
+		m.marks[m.currentTranslation][string(name)] = position
+	}
+	m.statusMessage = fmt.Sprintf("Mark %c set at %s %d:%d", name, position.Book, position.Chapter, position.Verse)
+}
+
+func (m model) mark(name rune) (Position, bool) {
+	if unicode.IsUpper(name) {
+		position, ok := m.globalMarks[string(name)]
+		return position, ok
+	}
+	position, ok := m.marks[m.currentTranslation][string(name)]
+	return position, ok
+}
+
+func (m *model) jumpToMark(name rune) tea.Cmd {
+	position, ok := m.mark(name)
+	if !ok {
+		m.statusMessage = fmt.Sprintf("Mark %c is not set", name)
+		return nil
+	}
+	m.recordJump()
+	return m.jumpTo(position.Translation, position.key())
+}
+
+func (m *model) deleteMark(name rune) {
+	if unicode.IsUpper(name) {
+		delete(m.globalMarks, string(name))
+		return
+	}
+	delete(m.marks[m.currentTranslation], string(name))
+	if len(m.marks[m.currentTranslation]) == 0 {
+		delete(m.marks, m.currentTranslation)
+	}
+}
+
+// markList returns the marks of the current translation, then the global
+// marks, in alphabetical order.
+func (m model) markList() []markEntry {
+	var entries []markEntry
+	for name := 'a'; name <= 'z'; name++ {
+		if position, ok := m.marks[m.currentTranslation][string(name)]; ok {
+			entries = append(entries, markEntry{name: name, position: position})
+		}
+	}
+	for name := 'A'; name <= 'Z'; name++ {
+		if position, ok := m.globalMarks[string(name)]; ok {
+			entries = append(entries, markEntry{name: name, position: position})
+		}
+	}
+	return entries
+}
+
+// openMarks shows the marks screen, remembering the reading position as
+// the bookmarks screen does.
+func (m *model) openMarks() {
+	m.savedSelected = m.selected
+	m.savedScrollOffset = m.scrollOffset
+	m.mode = marksMode
+	m.selected = 0
+	m.scrollOffset = 0
+}
+
+func (m *model) closeMarks() {
+	m.mode = navigationMode
+	m.selected = m.savedSelected
+	m.scrollOffset = m.savedScrollOffset
+}
+
+func (m *model) openSelectedMark() tea.Cmd {
+	entries := m.markList()
+	if m.selected >= len(entries) {
+		return nil
+	}
+	m.closeMarks()
+	return m.jumpToMark(entries[m.selected].name)
+}
+
+func (m *model) deleteSelectedMark() {
+	entries := m.markList()
+	if m.selected >= len(entries) {
+		return
+	}
+	m.deleteMark(entries[m.selected].name)
+	m.clampSelectedIndex(len(entries) - 1)
+}
+
+// renderMarks renders the marks screen below the header and returns the
+// number of lines used. Verse text is shown for translations that are
+// loaded.
+func (m *model) renderMarks(content *strings.Builder) int {
+	entries := m.markList()
+	if len(entries) == 0 {
+		content.WriteString(m.centerText("No marks yet. Press m and a letter on a verse to set one."))
+		content.WriteByte('\n')
+		return 1
+	}
+
+	items := make([]Verse, len(entries))
+	for i, entry := range entries {
+		position := entry.position
+		items[i] = Verse{Book: position.Book, Chapter: position.Chapter, Verse: position.Verse}
+		if m.multiBibleData.IsLoaded(position.Translation) {
+			if verse, ok := m.multiBibleData.GetCurrentBibleData(position.Translation).lookupVerse(position.key()); ok {
+				items[i].Text = verse.Text
+			}
+		}
+	}
+	return m.renderVerseList(content, items, func(i int) string {
+		position := entries[i].position
+		return fmt.Sprintf("%c %s %s %d:%d", entries[i].name, position.Translation, position.Book, position.Chapter, position.Verse)
+	})
+}
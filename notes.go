@@ -0,0 +1,217 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Notes are markdown files under the notes directory, one per verse,
+// named by canonical reference: notes/John/3-16.md. Spaces in book names
+// become underscores, so "1 John" is stored as notes/1_John. A note on a
+// passage is stored under its first verse, and a psalm title numbered as
+// verses under verse 0 and below: notes/Psalm/51-0.md.
+const maxNoteLines = 8
+
+func getNotesDir() (string, error) {
+	configDir, err := getConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "notes"), nil
+}
+
+func notePath(notesDir string, key verseKey) string {
+	book := strings.ReplaceAll(key.book, " ", "_")
+	return filepath.Join(notesDir, book, fmt.Sprintf("%d-%d.md", key.chapter, key.verse))
+}
+
+func noteHeading(key verseKey) string {
+	return fmt.Sprintf("# %s %d:%d", key.book, key.chapter, key.verse)
+}
+
+// noteBody returns a note without the heading line it was created with.
+func noteBody(note string) string {
+	note = strings.TrimSpace(note)
+	if strings.HasPrefix(note, "# ") {
+		_, note, _ = strings.Cut(note, "\n")
+	}
+	return strings.TrimSpace(note)
+}
+
+// loadNotes reads every note in the notes directory, keyed by canonical
+// reference. Files that do not follow the naming scheme are ignored.
+func loadNotes() map[verseKey]string {
+	notes := make(map[verseKey]string)
+	notesDir, err := getNotesDir()
+	if err != nil {
+		return notes
+	}
+
+	paths, _ := filepath.Glob(filepath.Join(notesDir, "*", "*.md"))
+	for _, path := range paths {
+		book := strings.ReplaceAll(filepath.Base(filepath.Dir(path)), "_", " ")
+		chapterStr, verseStr, ok := strings.Cut(strings.TrimSuffix(filepath.Base(path), ".md"), "-")
+		if !ok {
+			continue
+		}
+		chapter, err1 := strconv.Atoi(chapterStr)
+		verse, err2 := strconv.Atoi(verseStr)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		notes[verseKey{book: book, chapter: chapter, verse: verse}] = string(data)
+	}
+	return notes
+}
+
+type noteEditedMsg struct {
+	key  verseKey
+	path string
+	err  error
+}
+
+// editorCommand builds the command for $VISUAL or $EDITOR, which may
+// include arguments such as "code --wait".
+func editorCommand(path string) *exec.Cmd {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	parts := strings.Fields(editor)
+	if len(parts) == 0 {
+		parts = []string{"vi"}
+	}
+	return exec.Command(parts[0], append(parts[1:], path)...)
+}
+
+// editNote opens the note of the first of verses in the user's editor,
+// creating it with a heading naming the whole passage first if it does
+// not exist yet.
+func (m *model) editNote(verses []Verse) tea.Cmd {
+	if len(verses) == 0 {
+		return nil
+	}
+	key := m.multiBibleData.canonicalKey(m.currentTranslation, verses[0])
+	heading := noteHeading(key)
+	if len(verses) > 1 {
+		last := m.multiBibleData.canonicalKey(m.currentTranslation, verses[len(verses)-1])
+		heading = fmt.Sprintf("%s-%d", heading, last.verse)
+	}
+
+	notesDir, err := getNotesDir()
+	if err != nil {
+		m.statusMessage = fmt.Sprintf("Failed to open note: %v", err)
+		return nil
+	}
+	path := notePath(notesDir, key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		m.statusMessage = fmt.Sprintf("Failed to open note: %v", err)
+		return nil
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if err := os.WriteFile(path, []byte(heading+"\n\n"), 0o644); err != nil {
+			m.statusMessage = fmt.Sprintf("Failed to open note: %v", err)
+			return nil
+		}
+	}
+
+	return tea.ExecProcess(editorCommand(path), func(err error) tea.Msg {
+		return noteEditedMsg{key: key, path: path, err: err}
+	})
+}
+
+// updateNote reloads a note after editing. A note left with nothing but
+// its heading is deleted, so opening the editor by accident leaves no
+// empty files behind.
+func (m *model) updateNote(msg noteEditedMsg) {
+	if msg.err != nil {
+		m.statusMessage = fmt.Sprintf("Editor failed: %v", msg.err)
+	}
+
+	data, err := os.ReadFile(msg.path)
+	if err != nil {
+		delete(m.notes, msg.key)
+		return
+	}
+	if noteBody(string(data)) == "" {
+		os.Remove(msg.path)
+		os.Remove(filepath.Dir(msg.path))
+		delete(m.notes, msg.key)
+		return
+	}
+	m.notes[msg.key] = string(data)
+}
+
+func (m model) hasNote(verse Verse) bool {
+	_, ok := m.notes[m.multiBibleData.canonicalKey(m.currentTranslation, verse)]
+	return ok
+}
+
+// noteLines returns the note of a verse wrapped for the notes pane,
+// without its heading and cut to maxNoteLines.
+func (m model) noteLines(verse Verse) []string {
+	key := m.multiBibleData.canonicalKey(m.currentTranslation, verse)
+	note, ok := m.notes[key]
+	if !ok {
+		return nil
+	}
+	note = noteBody(note)
+
+	textWidth := max(20, m.width-verseTextPadding-2)
+	var lines []string
+	for _, paragraph := range strings.Split(note, "\n") {
+		if strings.TrimSpace(paragraph) == "" {
+			lines = append(lines, "")
+			continue
+		}
+		lines = append(lines, wrapVerseText(paragraph, textWidth)...)
+	}
+	if len(lines) > maxNoteLines {
+		lines = append(lines[:maxNoteLines-1], "…")
+	}
+	return lines
+}
+
+// showsNote reports whether the notes pane is open under verse.
+func (m model) showsNote(verse Verse) bool {
+	return m.notesPane && m.selected < len(m.verses) && m.verses[m.selected] == verse && m.hasNote(verse)
+}
+
+func (m model) noteHeight(verse Verse) int {
+	if !m.showsNote(verse) {
+		return 0
+	}
+	return len(m.noteLines(verse)) + 1
+}
+
+// renderNote renders the notes pane under the selected verse and returns
+// the number of lines used.
+func (m model) renderNote(content *strings.Builder, verse Verse) int {
+	if !m.showsNote(verse) {
+		return 0
+	}
+	border := m.verseNumStyle.Render("│ ")
+	noteStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	padding := strings.Repeat(" ", verseTextPadding)
+
+	lines := m.noteLines(verse)
+	for _, line := range lines {
+		content.WriteString(padding)
+		content.WriteString(border)
+		content.WriteString(noteStyle.Render(line))
+		content.WriteByte('\n')
+	}
+	content.WriteByte('\n')
+	return len(lines) + 1
+}
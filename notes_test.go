@@ -0,0 +1,74 @@
+package main
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNoteBody(t *testing.T) {
+	tests := []struct {
+		note string
+		want string
+	}{
+		{"# John 3:16\n\nFor God so loved\n", "For God so loved"},
+		{"# John 3:16-17\n\nFirst line\n\nSecond line\n\n", "First line\n\nSecond line"},
+		{"# John 3:16\n\n", ""},
+		{"# John 3:16", ""},
+		{"No heading\n", "No heading"},
+		{"\n\n# John 3:16\nBody", "Body"},
+		{"#hashtag\nBody", "#hashtag\nBody"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := noteBody(tt.note); got != tt.want {
+			t.Errorf("noteBody(%q) = %q, want %q", tt.note, got, tt.want)
+		}
+	}
+}
+
+func TestLoadNotes(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	notesDir := filepath.Join(configHome, "bible-go", "notes")
+
+	files := map[string]string{
+		"John/3-16.md":    "# John 3:16\n\nLoved\n",
+		"1_John/4-8.md":   "# 1 John 4:8\n\nLove\n",
+		"Psalm/51-0.md":   "# Psalm 51:0\n\nTitle\n",
+		"Psalm/51--1.md":  "# Psalm 51:-1\n\nFirst title verse\n",
+		"John/intro.md":   "not a verse",
+		"John/3.md":       "no verse number",
+		"John/3-x.md":     "bad verse number",
+		"John/3-16.txt":   "not markdown",
+		"John/3-16.md.md": "double extension",
+		"loose-1.md":      "outside a book directory",
+	}
+	for name, content := range files {
+		path := filepath.Join(notesDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	names := map[verseKey]string{
+		{"John", 3, 16}:   "John/3-16.md",
+		{"1 John", 4, 8}:  "1_John/4-8.md",
+		{"Psalm", 51, 0}:  "Psalm/51-0.md",
+		{"Psalm", 51, -1}: "Psalm/51--1.md",
+	}
+	want := make(map[verseKey]string)
+	for key, name := range names {
+		want[key] = files[name]
+		if path := notePath(notesDir, key); path != filepath.Join(notesDir, filepath.FromSlash(name)) {
+			t.Errorf("notePath(%v) = %s, want %s", key, path, name)
+		}
+	}
+	if got := loadNotes(); !maps.Equal(got, want) {
+		t.Errorf("loadNotes() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// NewBibleDataFromOSIS parses an OSIS XML document. Both container
+// verses (<verse osisID="...">text</verse>) and milestone verses
+// (<verse sID="..."/>text<verse eID="..."/>) are supported; notes are
+// dropped from the verse text.
+func NewBibleDataFromOSIS(xmlData []byte) (*BibleData, error) {
+	bible := make(Bible)
+	decoder := xml.NewDecoder(bytes.NewReader(xmlData))
+	decoder.Strict = false
+
+	var (
+		current   *osisVerseRef
+		container bool
+		text      strings.Builder
+		noteDepth int
+		sawOSIS   bool
+	)
+
+	flush := func() {
+		if current == nil {
+			return
+		}
+		if bible[current.book] == nil {
+			bible[current.book] = make(map[string]map[string]string)
+		}
+		chapter := strconv.Itoa(current.chapter)
+		if bible[current.book][chapter] == nil {
+			bible[current.book][chapter] = make(map[string]string)
+		}
+		verse := strconv.Itoa(current.verse)
+		bible[current.book][chapter][verse] = strings.Join(strings.Fields(text.String()), " ")
+		current = nil
+		text.Reset()
+	}
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			line, column := decoder.InputPos()
+			return nil, &SyntaxError{Format: "OSIS XML", Line: line, Column: column, Err: err}
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "osis":
+				sawOSIS = true
+			case "note":
+				noteDepth++
+			case "verse":
+				if osisAttr(t, "eID") != "" {
+					flush()
+					continue
+				}
+				id := osisAttr(t, "osisID")
+				if id == "" {
+					id = osisAttr(t, "sID")
+				}
+				ref, ok := parseOSISRef(id)
+				if !ok {
+					continue
+				}
+				flush()
+				current = &ref
+				container = osisAttr(t, "sID") == ""
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "note":
+				noteDepth = max(0, noteDepth-1)
+			case "verse":
+				if container {
+					flush()
+					container = false
+				}
+			}
+		case xml.CharData:
+			if current != nil && noteDepth == 0 {
+				text.Write(t)
+			}
+		}
+	}
+	flush()
+
+	if !sawOSIS {
+		return nil, fmt.Errorf("failed to parse OSIS XML: missing <osis> root element")
+	}
+	if len(bible) == 0 {
+		return nil, fmt.Errorf("failed to parse OSIS XML: no verses found")
+	}
+
+	return newBibleDataFromBible(bible), nil
+}
+
+type osisVerseRef struct {
+	book    string
+	chapter int
+	verse   int
+}
+
+// parseOSISRef parses an osisID such as "Gen.1.1". Combined verses
+// ("Gen.1.1 Gen.1.2") are filed under the first reference.
+func parseOSISRef(id string) (osisVerseRef, bool) {
+	fields := strings.Fields(id)
+	if len(fields) == 0 {
+		return osisVerseRef{}, false
+	}
+
+	parts := strings.Split(fields[0], ".")
+	if len(parts) != 3 {
+		return osisVerseRef{}, false
+	}
+	chapter, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return osisVerseRef{}, false
+	}
+	verse, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return osisVerseRef{}, false
+	}
+
+	book := parts[0]
+	if canonical := canonicalBook(book); canonical != "" {
+		book = canonical
+	}
+	return osisVerseRef{book: book, chapter: chapter, verse: verse}, true
+}
+
+func osisAttr(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
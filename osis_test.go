@@ -0,0 +1,106 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewBibleDataFromOSIS(t *testing.T) {
+	tests := []struct {
+		name string
+		xml  string
+		want []Verse
+	}{
+		{
+			name: "container verses",
+			xml: `<osis><osisText><div type="book" osisID="Gen"><chapter osisID="Gen.1">
+				<verse osisID="Gen.1.1">In the beginning</verse>
+				<verse osisID="Gen.1.2">And the earth</verse>
+			</chapter></div></osisText></osis>`,
+			want: []Verse{
+				{Book: "Genesis", Chapter: 1, Verse: 1, Text: "In the beginning"},
+				{Book: "Genesis", Chapter: 1, Verse: 2, Text: "And the earth"},
+			},
+		},
+		{
+			name: "milestone verses",
+			xml: `<osis><osisText><chapter sID="John.3"/>
+				<verse sID="John.3.16" osisID="John.3.16"/>For God <w>so</w> loved<verse eID="John.3.16"/>
+				<verse sID="John.3.17" osisID="John.3.17"/>For God sent not<verse eID="John.3.17"/>
+			<chapter eID="John.3"/></osisText></osis>`,
+			want: []Verse{
+				{Book: "John", Chapter: 3, Verse: 16, Text: "For God so loved"},
+				{Book: "John", Chapter: 3, Verse: 17, Text: "For God sent not"},
+			},
+		},
+		{
+			name: "notes dropped",
+			xml:  `<osis><verse osisID="Ps.23.1">The LORD <note>Heb. YHWH</note>is my shepherd</verse></osis>`,
+			want: []Verse{{Book: "Psalm", Chapter: 23, Verse: 1, Text: "The LORD is my shepherd"}},
+		},
+		{
+			name: "combined verses filed under the first",
+			xml:  `<osis><verse osisID="Rom.16.25 Rom.16.26">Now to him</verse></osis>`,
+			want: []Verse{{Book: "Romans", Chapter: 16, Verse: 25, Text: "Now to him"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bd, err := NewBibleDataFromOSIS([]byte(tt.xml))
+			if err != nil {
+				t.Fatalf("NewBibleDataFromOSIS: %v", err)
+			}
+			if !reflect.DeepEqual(bd.verses, tt.want) {
+				t.Errorf("verses = %v, want %v", bd.verses, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewBibleDataFromOSISErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		xml     string
+		syntax  bool
+		message string
+	}{
+		{name: "missing root", xml: `<bible><verse osisID="Gen.1.1">text</verse></bible>`, message: "missing <osis> root element"},
+		{name: "no verses", xml: `<osis><osisText></osisText></osis>`, message: "no verses found"},
+		{name: "malformed XML", xml: `<osis><verse osisID="Gen.1.1">text</verse><`, syntax: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBibleDataFromOSIS([]byte(tt.xml))
+			var syntaxErr *SyntaxError
+			if errors.As(err, &syntaxErr) != tt.syntax {
+				t.Fatalf("error = %v, want a SyntaxError: %v", err, tt.syntax)
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("error = %v, want it to mention %q", err, tt.message)
+			}
+		})
+	}
+}
+
+func TestParseOSISRef(t *testing.T) {
+	tests := []struct {
+		id   string
+		want osisVerseRef
+		ok   bool
+	}{
+		{"Gen.1.1", osisVerseRef{"Genesis", 1, 1}, true},
+		{"1Cor.13.4", osisVerseRef{"1 Corinthians", 13, 4}, true},
+		{"Tob.2.3", osisVerseRef{"Tob", 2, 3}, true},
+		{"Gen.1", osisVerseRef{}, false},
+		{"Gen.x.1", osisVerseRef{}, false},
+		{"", osisVerseRef{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseOSISRef(tt.id)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseOSISRef(%q) = %v, %v, want %v, %v", tt.id, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	maxParallelColumns     = 4
+	minParallelColumnWidth = 24
+	parallelColumnGap      = 3
+)
+
+// parallelCell is the text one translation shows for a verse in the
+// parallel view. Placeholders stand in for verses that are missing or
+// still loading and are rendered dimmed.
+type parallelCell struct {
+	text        string
+	placeholder bool
+}
+
+// parallelColumns returns the translations shown side by side: the
+// current one first, then the configured parallel translations, or the
+// next translation in the t/T rotation if none are configured.
+func (m model) parallelColumns() []string {
+	columns := []string{m.currentTranslation}
+	candidates := m.config.ParallelTranslations
+	if len(candidates) == 0 {
+		if neighbours := m.neighbourTranslations(); len(neighbours) > 0 {
+			candidates = neighbours[len(neighbours)-1:]
+		}
+	}
+	for _, name := range candidates {
+		if len(columns) == maxParallelColumns {
+			break
+		}
+		if contains(m.multiBibleData.translationNames, name) && !contains(columns, name) {
+			columns = append(columns, name)
+		}
+	}
+	return columns
+}
+
+// parallelStacked reports whether the terminal is too narrow for columns,
+// in which case each verse lists the translations below each other.
+func (m model) parallelStacked(columns []string) bool {
+	needed := verseTextPadding + len(columns)*minParallelColumnWidth + (len(columns)-1)*parallelColumnGap
+	return m.width < needed
+}
+
+func (m model) parallelColumnWidth(columns []string) int {
+	if m.parallelStacked(columns) {
+		return max(20, m.width-verseTextPadding-m.parallelLabelWidth(columns)-1)
+	}
+	return (m.width - verseTextPadding - (len(columns)-1)*parallelColumnGap) / len(columns)
+}
+
+func (m model) parallelLabelWidth(columns []string) int {
+	width := 0
+	for _, name := range columns {
+		width = max(width, lipgloss.Width(name))
+	}
+	return width
+}
+
+// loadParallelColumns starts loading the parallel and compared
+// translations that are not in the cache yet. Their columns show a
+// placeholder until then.
+func (m model) loadParallelColumns() tea.Cmd {
+	if !m.parallel && !m.compare {
+		return nil
+	}
+	var cmds []tea.Cmd
+	for _, name := range m.parallelColumns() {
+		if !m.multiBibleData.IsLoaded(name) && m.multiBibleData.LoadError(name) == nil {
+			cmds = append(cmds, loadTranslationCmd(m.multiBibleData, name))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// parallelCells looks verse up in every column, mapping its reference
+// into the verse numbering of each translation.
+func (m model) parallelCells(verse Verse, columns []string) []parallelCell {
+	cells := make([]parallelCell, len(columns))
+	ref := verseKey{book: verse.Book, chapter: verse.Chapter, verse: verse.Verse}
+	for i, name := range columns {
+		switch {
+		case name == m.currentTranslation:
+			cells[i] = parallelCell{text: verse.Text}
+		case m.multiBibleData.LoadError(name) != nil:
+			cells[i] = parallelCell{text: "(failed to load)", placeholder: true}
+		case !m.multiBibleData.IsLoaded(name):
+			cells[i] = parallelCell{text: "loading…", placeholder: true}
+		default:
+			bibleData := m.multiBibleData.GetCurrentBibleData(name)
+			target := m.multiBibleData.MapVerse(ref, m.currentTranslation, name)
+			target.book = bibleData.translationBook(target.book)
+			if found, ok := bibleData.lookupVerse(target); ok {
+				cells[i] = parallelCell{text: found.Text}
+			} else {
+				cells[i] = parallelCell{text: "—", placeholder: true}
+			}
+		}
+	}
+	return cells
+}
+
+func (m model) parallelVerseHeight(verse Verse) int {
+	columns := m.parallelColumns()
+	width := m.parallelColumnWidth(columns)
+	height := 0
+	for _, cell := range m.parallelCells(verse, columns) {
+		lines := len(wrapVerseText(cell.text, width))
+		if m.parallelStacked(columns) {
+			height += lines
+		} else {
+			height = max(height, lines)
+		}
+	}
+	return max(1, height) + 1
+}
+
+// renderParallelTitles renders the translation names above the columns.
+// Stacked layouts label every line instead and get a blank line here.
+func (m model) renderParallelTitles() string {
+	columns := m.parallelColumns()
+	if m.parallelStacked(columns) {
+		return ""
+	}
+	width := m.parallelColumnWidth(columns)
+	var titles strings.Builder
+	titles.WriteString(strings.Repeat(" ", verseTextPadding))
+	for i, name := range columns {
+		if i > 0 {
+			titles.WriteString(strings.Repeat(" ", parallelColumnGap))
+		}
+		titles.WriteString(m.bookStyle.Render(truncateText(name, width)))
+		if i < len(columns)-1 {
+			titles.WriteString(strings.Repeat(" ", max(0, width-lipgloss.Width(truncateText(name, width)))))
+		}
+	}
+	return titles.String()
+}
+
+// renderParallelVerse renders one verse in every parallel translation,
+// either side by side or stacked, and returns the number of lines used.
+func (m model) renderParallelVerse(content *strings.Builder, verse Verse, isSelected bool, verseNumStr string) int {
+	columns := m.parallelColumns()
+	width := m.parallelColumnWidth(columns)
+	cells := m.parallelCells(verse, columns)
+
+	wrapped := make([][]string, len(cells))
+	for i, cell := range cells {
+		wrapped[i] = wrapVerseText(cell.text, width)
+	}
+
+	var lines []string
+	if m.parallelStacked(columns) {
+		labelWidth := m.parallelLabelWidth(columns)
+		for i, cellLines := range wrapped {
+			for j, line := range cellLines {
+				label := strings.Repeat(" ", labelWidth)
+				if j == 0 {
+					label = m.verseNumStyle.Render(columns[i] + strings.Repeat(" ", labelWidth-lipgloss.Width(columns[i])))
+				}
+				lines = append(lines, label+" "+m.parallelCellStyle(cells[i]).Render(line))
+			}
+		}
+	} else {
+		height := 0
+		for _, cellLines := range wrapped {
+			height = max(height, len(cellLines))
+		}
+		separator := m.dimStyle.Render(" │ ")
+		for row := 0; row < height; row++ {
+			var line strings.Builder
+			for i, cellLines := range wrapped {
+				if i > 0 {
+					line.WriteString(separator)
+				}
+				text := ""
+				if row < len(cellLines) {
+					text = cellLines[row]
+				}
+				line.WriteString(m.parallelCellStyle(cells[i]).Render(text))
+				if i < len(wrapped)-1 {
+					line.WriteString(strings.Repeat(" ", max(0, width-lipgloss.Width(text))))
+				}
+			}
+			lines = append(lines, line.String())
+		}
+	}
+
+	if isSelected {
+		cursorStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(m.config.HighlightColor)).
+			Bold(true)
+		content.WriteString(cursorStyle.Render(">"))
+	} else {
+		content.WriteString(" ")
+	}
+	content.WriteByte(' ')
+	content.WriteString(verseNumStr)
+	content.WriteByte(' ')
+
+	padding := strings.Repeat(" ", verseTextPadding)
+	for i, line := range lines {
+		if i > 0 {
+			content.WriteString(padding)
+		}
+		content.WriteString(line)
+		content.WriteByte('\n')
+	}
+	if len(lines) == 0 {
+		content.WriteByte('\n')
+	}
+
+	content.WriteByte('\n')
+	return max(1, len(lines)) + 1
+}
+
+func (m model) parallelCellStyle(cell parallelCell) lipgloss.Style {
+	if cell.placeholder {
+		return m.dimStyle
+	}
+	return m.textStyle
+}
@@ -0,0 +1,402 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// The picker is an overlay for jumping straight to a book and chapter: a
+// grid of books grouped by section, then a grid of the book's chapters.
+// Typing filters the grid, the arrow keys move the selection and Enter or
+// a mouse click picks. The mouse is only captured while the picker is
+// open, so text can still be selected in the terminal otherwise.
+
+// bookSections groups biblicalOrder into sections; first is the index of
+// the first book of the section.
+var bookSections = []struct {
+	title string
+	first int
+}{
+	{"Old Testament · Law", 0},
+	{"Old Testament · History", 5},
+	{"Old Testament · Poetry", 17},
+	{"Old Testament · Major Prophets", 22},
+	{"Old Testament · Minor Prophets", 27},
+	{"New Testament · Gospels", 39},
+	{"New Testament · History", 43},
+	{"New Testament · Pauline Epistles", 44},
+	{"New Testament · General Epistles", 57},
+	{"New Testament · Prophecy", 65},
+}
+
+const pickerGridTop = 2 // screen lines above the grid: header and a blank line
+
+// bookSection returns the section title of a book; books outside
+// biblicalOrder, such as the deuterocanon, are grouped under "Other".
+func bookSection(book string) string {
+	canonical := canonicalBook(book)
+	for i, name := range biblicalOrder {
+		if name != canonical {
+			continue
+		}
+		title := ""
+		for _, section := range bookSections {
+			if i >= section.first {
+				title = section.title
+			}
+		}
+		return title
+	}
+	return "Other"
+}
+
+// pickerItem is a cell of the picker grid: a book in the book grid, or a
+// chapter of pickerBook in the chapter grid.
+type pickerItem struct {
+	label   string
+	section string
+	book    string
+	chapter int
+}
+
+// pickerLine is a line of the laid out grid: a section title, or a row of
+// indices into the items.
+type pickerLine struct {
+	title string
+	cells []int
+}
+
+func (bd *BibleData) chapters(book string) []int {
+	chapters := make([]int, 0, len(bd.chapterIndex[book]))
+	for chapter := 1; chapter <= bd.lastChapter(book); chapter++ {
+		if _, ok := bd.chapterIndex[book][chapter]; ok {
+			chapters = append(chapters, chapter)
+		}
+	}
+	return chapters
+}
+
+// pickerItems returns the items of the current grid matching the filter.
+// Books match by name or abbreviation, so "john" finds all four books of
+// John and "mt" finds Matthew; chapters match by leading digits.
+func (m *model) pickerItems() []pickerItem {
+	bibleData := m.getBibleData()
+	var items []pickerItem
+	if m.pickerBook == "" {
+		filter := normalizeBookName(m.pickerFilter)
+		for _, book := range bibleData.GetBooks() {
+			if filter != "" && !bookMatchesFilter(book, filter) {
+				continue
+			}
+			items = append(items, pickerItem{label: book, section: bookSection(book), book: book})
+		}
+		return items
+	}
+
+	for _, chapter := range bibleData.chapters(m.pickerBook) {
+		label := strconv.Itoa(chapter)
+		if strings.HasPrefix(label, m.pickerFilter) {
+			items = append(items, pickerItem{label: label, book: m.pickerBook, chapter: chapter})
+		}
+	}
+	return items
+}
+
+func bookMatchesFilter(book, filter string) bool {
+	if strings.Contains(normalizeBookName(book), filter) {
+		return true
+	}
+	for _, alias := range bookAliasesByBook[canonicalBook(book)] {
+		if strings.HasPrefix(alias, filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// pickerColumns returns the column width and the number of columns of
+// the grid.
+func (m model) pickerColumns(items []pickerItem) (int, int) {
+	colWidth := 4
+	for _, item := range items {
+		colWidth = max(colWidth, len(item.label)+2)
+	}
+	return colWidth, max(1, (m.width-4)/colWidth)
+}
+
+// pickerLayout lays items out in rows, starting a new row under a title
+// line at each section.
+func (m model) pickerLayout(items []pickerItem) []pickerLine {
+	_, columns := m.pickerColumns(items)
+	var lines []pickerLine
+	for i, item := range items {
+		if i == 0 || item.section != items[i-1].section {
+			if item.section != "" {
+				lines = append(lines, pickerLine{title: item.section})
+			}
+			lines = append(lines, pickerLine{})
+		} else if len(lines[len(lines)-1].cells) == columns {
+			lines = append(lines, pickerLine{})
+		}
+		lines[len(lines)-1].cells = append(lines[len(lines)-1].cells, i)
+	}
+	return lines
+}
+
+// pickerPosition returns the line and column of the selected item.
+func pickerPosition(lines []pickerLine, selected int) (int, int) {
+	for l, line := range lines {
+		for c, index := range line.cells {
+			if index == selected {
+				return l, c
+			}
+		}
+	}
+	return 0, 0
+}
+
+func (m *model) openPicker() tea.Cmd {
+	m.mode = pickerMode
+	m.pickerBook = ""
+	m.pickerFilter = ""
+	m.pickerScroll = 0
+	m.selectPickerItem(func(item pickerItem) bool { return item.book == m.currentBook })
+	return tea.EnableMouseCellMotion
+}
+
+func (m *model) closePicker() tea.Cmd {
+	m.mode = navigationMode
+	m.pickerBook = ""
+	m.pickerFilter = ""
+	return tea.DisableMouse
+}
+
+// selectPickerItem selects the first item matching, or the first item if
+// none does, and scrolls it into view.
+func (m *model) selectPickerItem(match func(pickerItem) bool) {
+	m.pickerSelected = 0
+	for i, item := range m.pickerItems() {
+		if match(item) {
+			m.pickerSelected = i
+			break
+		}
+	}
+	m.scrollPicker()
+}
+
+// pickerRows returns the number of grid lines that fit on screen.
+func (m model) pickerRows() int {
+	return max(3, m.height-pickerGridTop-2)
+}
+
+// scrollPicker keeps the selected item, and the title of its section when
+// it is in the section's first row, in view.
+func (m *model) scrollPicker() {
+	lines := m.pickerLayout(m.pickerItems())
+	line, _ := pickerPosition(lines, m.pickerSelected)
+	top := line
+	if line > 0 && lines[line-1].title != "" {
+		top = line - 1
+	}
+	rows := m.pickerRows()
+	m.pickerScroll = min(m.pickerScroll, top)
+	if line >= m.pickerScroll+rows {
+		m.pickerScroll = line - rows + 1
+	}
+	m.pickerScroll = max(0, min(m.pickerScroll, len(lines)-rows))
+}
+
+// movePicker moves the selection by columns or by rows; moving by rows
+// skips section titles and keeps the column where the row is long enough.
+func (m *model) movePicker(dx, dy int) {
+	items := m.pickerItems()
+	if len(items) == 0 {
+		return
+	}
+	if dx != 0 {
+		m.pickerSelected = max(0, min(len(items)-1, m.pickerSelected+dx))
+	}
+	if dy != 0 {
+		lines := m.pickerLayout(items)
+		line, column := pickerPosition(lines, m.pickerSelected)
+		for l := line + dy; l >= 0 && l < len(lines); l += dy {
+			if cells := lines[l].cells; len(cells) > 0 {
+				m.pickerSelected = cells[min(column, len(cells)-1)]
+				break
+			}
+		}
+	}
+	m.scrollPicker()
+}
+
+// pick opens the chosen book's chapter grid, or goes to the chosen
+// chapter. A book with a single chapter, such as Obadiah, is opened
+// directly.
+func (m *model) pick(item pickerItem) tea.Cmd {
+	if item.chapter == 0 {
+		chapters := m.getBibleData().chapters(item.book)
+		if len(chapters) != 1 {
+			m.pickerBook = item.book
+			m.pickerFilter = ""
+			m.pickerScroll = 0
+			m.selectPickerItem(func(i pickerItem) bool {
+				return i.book == m.currentBook && i.chapter == m.currentChapter
+			})
+			return nil
+		}
+		item.chapter = chapters[0]
+	}
+
+	cmd := m.closePicker()
+	m.recordJump()
+	m.currentBook = item.book
+	m.currentChapter = item.chapter
+	m.resetVerseView(m.getBibleData())
+	return cmd
+}
+
+func (m *model) pickSelected() tea.Cmd {
+	items := m.pickerItems()
+	if m.pickerSelected >= len(items) {
+		return nil
+	}
+	return m.pick(items[m.pickerSelected])
+}
+
+// handlePickerKey handles keys while the picker is open. Letters and
+// digits go to the filter, so the grid is navigated with the arrow keys.
+func (m *model) handlePickerKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m.closePicker()
+	case tea.KeyEsc:
+		if m.pickerFilter != "" {
+			m.pickerFilter = ""
+			m.selectPickerItem(func(pickerItem) bool { return false })
+			return nil
+		}
+		if m.pickerBook != "" {
+			book := m.pickerBook
+			m.pickerBook = ""
+			m.selectPickerItem(func(item pickerItem) bool { return item.book == book })
+			return nil
+		}
+		return m.closePicker()
+	case tea.KeyEnter:
+		return m.pickSelected()
+	case tea.KeyBackspace:
+		if m.pickerFilter != "" {
+			runes := []rune(m.pickerFilter)
+			m.pickerFilter = string(runes[:len(runes)-1])
+			m.selectPickerItem(func(pickerItem) bool { return false })
+		}
+	case tea.KeyLeft, tea.KeyShiftTab:
+		m.movePicker(-1, 0)
+	case tea.KeyRight, tea.KeyTab:
+		m.movePicker(1, 0)
+	case tea.KeyUp:
+		m.movePicker(0, -1)
+	case tea.KeyDown:
+		m.movePicker(0, 1)
+	case tea.KeySpace:
+		if m.pickerBook == "" {
+			m.pickerFilter += " "
+		}
+	case tea.KeyRunes:
+		for _, r := range msg.Runes {
+			if m.pickerBook != "" && (r < '0' || r > '9') {
+				return nil
+			}
+		}
+		m.pickerFilter += string(msg.Runes)
+		m.selectPickerItem(func(pickerItem) bool { return false })
+	}
+	return nil
+}
+
+// handlePickerMouse picks the clicked item; the wheel moves the selection
+// by rows.
+func (m *model) handlePickerMouse(msg tea.MouseMsg) tea.Cmd {
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		m.movePicker(0, -1)
+		return nil
+	case tea.MouseButtonWheelDown:
+		m.movePicker(0, 1)
+		return nil
+	}
+	if msg.Button != tea.MouseButtonLeft || msg.Action != tea.MouseActionPress {
+		return nil
+	}
+
+	items := m.pickerItems()
+	lines := m.pickerLayout(items)
+	line := msg.Y - pickerGridTop + m.pickerScroll
+	if msg.Y < pickerGridTop || line >= len(lines) || line >= m.pickerScroll+m.pickerRows() {
+		return nil
+	}
+	colWidth, _ := m.pickerColumns(items)
+	column := msg.X - m.pickerMargin(items)
+	if column < 0 || column/colWidth >= len(lines[line].cells) {
+		return nil
+	}
+	m.pickerSelected = lines[line].cells[column/colWidth]
+	return m.pick(items[m.pickerSelected])
+}
+
+// pickerMargin returns the left margin that centers the grid.
+func (m model) pickerMargin(items []pickerItem) int {
+	colWidth, columns := m.pickerColumns(items)
+	columns = min(columns, len(items))
+	return max(0, (m.width-columns*colWidth)/2)
+}
+
+func (m model) pickerHeader() string {
+	if m.pickerBook == "" {
+		return fmt.Sprintf("Go to book: %s", m.pickerFilter)
+	}
+	return fmt.Sprintf("Go to %s chapter: %s", m.pickerBook, m.pickerFilter)
+}
+
+// renderPicker renders the visible lines of the grid and returns the
+// number of lines used. The current book and chapter are shown in the
+// verse number color.
+func (m model) renderPicker(content *strings.Builder) int {
+	items := m.pickerItems()
+	if len(items) == 0 {
+		content.WriteString(m.centerText(fmt.Sprintf("Nothing matches %q", m.pickerFilter)))
+		content.WriteByte('\n')
+		return 1
+	}
+
+	lines := m.pickerLayout(items)
+	colWidth, _ := m.pickerColumns(items)
+	margin := strings.Repeat(" ", m.pickerMargin(items))
+	selectedStyle := m.bookStyle.Reverse(true)
+	end := min(len(lines), m.pickerScroll+m.pickerRows())
+
+	for _, line := range lines[m.pickerScroll:end] {
+		content.WriteString(margin)
+		if line.title != "" {
+			content.WriteString(m.dimStyle.Render(line.title))
+		}
+		for _, index := range line.cells {
+			item := items[index]
+			label := item.label + strings.Repeat(" ", colWidth-len(item.label)-1)
+			style := m.textStyle
+			if item.book == m.currentBook && (item.chapter == 0 || item.chapter == m.currentChapter) {
+				style = m.verseNumStyle
+			}
+			if index == m.pickerSelected {
+				style = selectedStyle
+			}
+			content.WriteString(style.Render(label))
+			content.WriteByte(' ')
+		}
+		content.WriteByte('\n')
+	}
+	return end - m.pickerScroll
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// verseRange is a contiguous span of verses inside one book. A zero
+// startVerse means "from the start of the chapter" and a zero endVerse
+// means "to the end of the chapter".
+type verseRange struct {
+	book         string
+	startChapter int
+	startVerse   int
+	endChapter   int
+	endVerse     int
+}
+
+type verseKey struct {
+	book    string
+	chapter int
+	verse   int
+}
+
+var (
+	referenceSegmentPattern = regexp.MustCompile(`^(.*?)\s*(\d[\d\s:.,\-–—]*)?$`)
+	referenceDashReplacer   = strings.NewReplacer("–", "-", "—", "-")
+)
+
+// parseReference understands single references and lists of them:
+//
+//	John 3:16-18
+//	Rom 8:28,31,35-39
+//	Gen 1:26-2:3
+//	Ps 23; Ps 121
+//	Matt 5-7
+//
+// A segment after ';' without a book name reuses the previous book. It
+// returns false if any part of the query is not a valid reference.
+func (bd *BibleData) parseReference(query string) ([]verseRange, bool) {
+	var ranges []verseRange
+	book := ""
+
+	for _, segment := range strings.Split(query, ";") {
+		segment = strings.TrimSpace(segment)
+		if segment == "" {
+			continue
+		}
+
+		match := referenceSegmentPattern.FindStringSubmatch(segment)
+		if match == nil {
+			return nil, false
+		}
+
+		if name := strings.TrimSpace(match[1]); name != "" {
+			book = bd.findBook(name)
+		}
+		if book == "" {
+			return nil, false
+		}
+
+		spec := strings.TrimSpace(match[2])
+		if spec == "" {
+			ranges = append(ranges, verseRange{
+				book:         book,
+				startChapter: 1,
+				endChapter:   bd.lastChapter(book),
+			})
+			continue
+		}
+
+		specRanges, ok := parseChapterSpec(book, spec, bd.lastChapter(book))
+		if !ok {
+			return nil, false
+		}
+		ranges = append(ranges, specRanges...)
+	}
+
+	return ranges, len(ranges) > 0
+}
+
+// parseChapterSpec parses the part of a reference after the book name,
+// e.g. "8:28,31,35-39" or "5-7". A bare number following an item that
+// named a verse is read as another verse in the same chapter. Ranges
+// running past lastChapter end with it, so "Gen 1-1000" stays cheap.
+func parseChapterSpec(book, spec string, lastChapter int) ([]verseRange, bool) {
+	spec = referenceDashReplacer.Replace(spec)
+	spec = strings.ReplaceAll(spec, ".", ":")
+
+	var ranges []verseRange
+	chapter := 0
+	verseContext := false
+
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			return nil, false
+		}
+
+		bounds := strings.Split(item, "-")
+		if len(bounds) > 2 {
+			return nil, false
+		}
+
+		startChapter, startVerse, ok := parseReferencePoint(bounds[0], chapter, verseContext)
+		if !ok {
+			return nil, false
+		}
+
+		r := verseRange{
+			book:         book,
+			startChapter: startChapter,
+			startVerse:   startVerse,
+			endChapter:   startChapter,
+			endVerse:     startVerse,
+		}
+
+		if len(bounds) == 2 {
+			endChapter, endVerse, ok := parseReferencePoint(bounds[1], startChapter, startVerse > 0)
+			if !ok {
+				return nil, false
+			}
+			r.endChapter, r.endVerse = endChapter, endVerse
+		}
+
+		if r.endChapter < r.startChapter ||
+			(r.endChapter == r.startChapter && r.endVerse > 0 && r.endVerse < r.startVerse) {
+			return nil, false
+		}
+		if r.endChapter > lastChapter && r.endChapter > r.startChapter {
+			r.endChapter, r.endVerse = max(r.startChapter, lastChapter), 0
+		}
+
+		ranges = append(ranges, r)
+		chapter = r.endChapter
+		verseContext = r.endVerse > 0
+	}
+
+	return ranges, true
+}
+
+// parseReferencePoint parses "C:V" or a bare number. Depending on the
+// context a bare number is either a verse in chapter or a chapter.
+func parseReferencePoint(text string, chapter int, verseContext bool) (int, int, bool) {
+	text = strings.TrimSpace(text)
+
+	if chapterText, verseText, found := strings.Cut(text, ":"); found {
+		c, err := strconv.Atoi(strings.TrimSpace(chapterText))
+		if err != nil || c <= 0 {
+			return 0, 0, false
+		}
+		v, err := strconv.Atoi(strings.TrimSpace(verseText))
+		if err != nil || v <= 0 {
+			return 0, 0, false
+		}
+		return c, v, true
+	}
+
+	n, err := strconv.Atoi(text)
+	if err != nil || n <= 0 {
+		return 0, 0, false
+	}
+	if verseContext {
+		return chapter, n, true
+	}
+	return n, 0, true
+}
+
+func (bd *BibleData) lastChapter(book string) int {
+	last := 0
+	for chapter := range bd.chapterIndex[book] {
+		last = max(last, chapter)
+	}
+	return last
+}
+
+// versesInRanges resolves ranges in the order given, dropping verses that
+// were already returned by an earlier range.
+func (bd *BibleData) versesInRanges(ranges []verseRange) []Verse {
+	var results []Verse
+	seen := make(map[verseKey]bool)
+
+	for _, r := range ranges {
+		for chapter := r.startChapter; chapter <= r.endChapter; chapter++ {
+			for _, verse := range bd.GetVerses(r.book, chapter) {
+				if chapter == r.startChapter && verse.Verse < r.startVerse {
+					continue
+				}
+				if chapter == r.endChapter && r.endVerse > 0 && verse.Verse > r.endVerse {
+					continue
+				}
+				key := verseKey{book: verse.Book, chapter: verse.Chapter, verse: verse.Verse}
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				results = append(results, verse)
+			}
+		}
+	}
+
+	return results
+}
+
+func (bd *BibleData) searchByReference(query string) []Verse {
+	ranges, ok := bd.parseReference(strings.TrimSpace(query))
+	if !ok {
+		return nil
+	}
+	return bd.versesInRanges(ranges)
+}
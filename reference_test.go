@@ -0,0 +1,87 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+const referenceTestBible = `{
+	"Genesis": {"1": {"26": "Let us make man", "27": "So God created man"}, "2": {"1": "Thus the heavens", "2": "And on the seventh day", "3": "And God blessed"}},
+	"Psalm": {"23": {"1": "The LORD is my shepherd"}, "121": {"1": "I will lift up mine eyes"}},
+	"Matthew": {"5": {"1": "And seeing the multitudes"}, "6": {"1": "Take heed"}, "7": {"1": "Judge not"}},
+	"John": {"1": {"1": "In the beginning was the Word"}, "3": {"16": "For God so loved", "17": "For God sent not", "18": "He that believeth"}}
+}`
+
+func TestParseReference(t *testing.T) {
+	bd, err := NewBibleData([]byte(referenceTestBible))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		query string
+		want  []verseRange
+	}{
+		{"John 3:16", []verseRange{{"John", 3, 16, 3, 16}}},
+		{"John 3:16-18", []verseRange{{"John", 3, 16, 3, 18}}},
+		{"jn 3.16–18", []verseRange{{"John", 3, 16, 3, 18}}},
+		{"John 3:16,17-18", []verseRange{{"John", 3, 16, 3, 16}, {"John", 3, 17, 3, 18}}},
+		{"Gen 1:26-2:3", []verseRange{{"Genesis", 1, 26, 2, 3}}},
+		{"Gen 1:26,27", []verseRange{{"Genesis", 1, 26, 1, 26}, {"Genesis", 1, 27, 1, 27}}},
+		{"Ps 23; Ps 121", []verseRange{{"Psalm", 23, 0, 23, 0}, {"Psalm", 121, 0, 121, 0}}},
+		{"Ps 23; 121", []verseRange{{"Psalm", 23, 0, 23, 0}, {"Psalm", 121, 0, 121, 0}}},
+		{"Matt 5-7", []verseRange{{"Matthew", 5, 0, 7, 0}}},
+		{"John", []verseRange{{"John", 1, 0, 3, 0}}},
+		{"Matt 5-1000000000", []verseRange{{"Matthew", 5, 0, 7, 0}}},
+		{"Gen 1:26-9:1", []verseRange{{"Genesis", 1, 26, 2, 0}}},
+		{"John 4-9", []verseRange{{"John", 4, 0, 4, 0}}},
+		{"John 3:18-16", nil},
+		{"John 3-1", nil},
+		{"John 0:1", nil},
+		{"John 3:16-17-18", nil},
+		{"John 3:", nil},
+		{"Unknown 1:1", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, ok := bd.parseReference(tt.query)
+			if ok != (tt.want != nil) {
+				t.Fatalf("parseReference(%q) ok = %v, want %v", tt.query, ok, tt.want != nil)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseReference(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchByReference(t *testing.T) {
+	bd, err := NewBibleData([]byte(referenceTestBible))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"Gen 1:27-2:2", []string{"Genesis 1:27", "Genesis 2:1", "Genesis 2:2"}},
+		{"John 3:17-18; 3:16-17", []string{"John 3:17", "John 3:18", "John 3:16"}},
+		{"Matt 6", []string{"Matthew 6:1"}},
+		{"Gen 2-1000000000", []string{"Genesis 2:1", "Genesis 2:2", "Genesis 2:3"}},
+		{"John 4", nil},
+		{"not a reference", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			var got []string
+			for _, verse := range bd.searchByReference(tt.query) {
+				got = append(got, formatPassage([]Verse{verse}))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("searchByReference(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Search runs as the query is typed. Each edit bumps searchSeq and starts
+// a short timer; only the timer of the latest edit starts a search, which
+// runs off the UI goroutine. Results that arrive for an older searchSeq
+// are dropped, so a slow search never overwrites newer results. Enter
+// skips the timer; its results also leave the query for the results.
+const searchDebounce = 150 * time.Millisecond
+
+type searchDebounceMsg struct {
+	seq int
+}
+
+type searchResultsMsg struct {
+	seq     int
+	query   string
+	results []Verse
+	browse  bool
+}
+
+func searchCmd(bibleData *BibleData, seq int, query string, browse bool) tea.Cmd {
+	return func() tea.Msg {
+		return searchResultsMsg{seq: seq, query: query, results: bibleData.Search(query), browse: browse}
+	}
+}
+
+// openSearch starts editing a new query, remembering the selected verse
+// for the jump list.
+func (m *model) openSearch() {
+	m.savedSelected = m.selected
+	m.mode = searchMode
+	m.searchEditing = true
+	m.searchQuery = ""
+	m.searchedQuery = ""
+	m.searchResults = nil
+	m.selected = 0
+	m.scrollOffset = 0
+}
+
+func (m *model) closeSearch() {
+	m.searchSeq++
+	m.mode = navigationMode
+	m.searchEditing = false
+	m.searchQuery = ""
+	m.searchedQuery = ""
+	m.searchResults = nil
+}
+
+// scheduleSearch starts the debounce timer after the query changed.
+func (m *model) scheduleSearch() tea.Cmd {
+	m.searchSeq++
+	if strings.TrimSpace(m.searchQuery) == "" {
+		m.searchResults = nil
+		m.searchedQuery = ""
+		return nil
+	}
+	seq := m.searchSeq
+	return tea.Tick(searchDebounce, func(time.Time) tea.Msg {
+		return searchDebounceMsg{seq: seq}
+	})
+}
+
+// updateSearchResults shows the results of the latest search; results of
+// an older query, or arriving after search was left, are discarded.
+func (m *model) updateSearchResults(msg searchResultsMsg) {
+	if msg.seq != m.searchSeq || m.mode != searchMode {
+		return
+	}
+	m.searchResults = msg.results
+	m.searchedQuery = msg.query
+	m.selected = 0
+	m.scrollOffset = 0
+	if msg.browse && len(msg.results) > 0 {
+		m.searchEditing = false
+	}
+}
+
+// searchPending reports whether the results do not match the query yet.
+func (m model) searchPending() bool {
+	return strings.TrimSpace(m.searchQuery) != "" && m.searchQuery != m.searchedQuery
+}
+
+// handleSearchInput edits the query. The arrow keys move through the
+// results while typing; Enter searches at once and moves to the results,
+// where j/k, y and H work, once they are in.
+func (m *model) handleSearchInput(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyCtrlC, tea.KeyEsc:
+		m.closeSearch()
+	case tea.KeyEnter:
+		if strings.TrimSpace(m.searchQuery) == "" {
+			return nil
+		}
+		if m.searchPending() {
+			m.searchSeq++
+			return searchCmd(m.getBibleData(), m.searchSeq, m.searchQuery, true)
+		}
+		if len(m.searchResults) > 0 {
+			m.searchEditing = false
+		}
+	case tea.KeyUp:
+		m.handleMovement("up")
+	case tea.KeyDown:
+		m.handleMovement("down")
+	case tea.KeyBackspace:
+		if m.searchQuery == "" {
+			return nil
+		}
+		runes := []rune(m.searchQuery)
+		m.searchQuery = string(runes[:len(runes)-1])
+		return m.scheduleSearch()
+	case tea.KeyCtrlU:
+		m.searchQuery = ""
+		return m.scheduleSearch()
+	case tea.KeySpace:
+		m.searchQuery += " "
+		return m.scheduleSearch()
+	case tea.KeyRunes:
+		m.searchQuery += string(msg.Runes)
+		return m.scheduleSearch()
+	}
+	return nil
+}
+
+// searchInput returns the query for the header, with a cursor while it
+// is being edited.
+func (m model) searchInput() string {
+	if m.searchEditing {
+		return m.searchQuery + "▏"
+	}
+	return m.searchQuery
+}
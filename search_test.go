@@ -0,0 +1,102 @@
+package main
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestScheduleSearch(t *testing.T) {
+	tests := []struct {
+		query   string
+		wantCmd bool
+	}{
+		{query: "light", wantCmd: true},
+		{query: "  ", wantCmd: false},
+		{query: "", wantCmd: false},
+	}
+	for _, tt := range tests {
+		m := newTestModel(t)
+		m.openSearch()
+		m.searchResults = []Verse{{Book: "Genesis", Chapter: 1, Verse: 1}}
+		seq := m.searchSeq
+		m.searchQuery = tt.query
+		cmd := m.scheduleSearch()
+		if (cmd != nil) != tt.wantCmd {
+			t.Errorf("scheduleSearch() for %q returned a command: %v, want %v", tt.query, cmd != nil, tt.wantCmd)
+		}
+		if m.searchSeq != seq+1 {
+			t.Errorf("searchSeq = %d, want %d", m.searchSeq, seq+1)
+		}
+		if !tt.wantCmd && m.searchResults != nil {
+			t.Errorf("results for an empty query = %v, want none", m.searchResults)
+		}
+	}
+}
+
+func TestUpdateSearchResults(t *testing.T) {
+	results := []Verse{{Book: "Genesis", Chapter: 1, Verse: 3, Text: "And God said, Let there be light"}}
+	tests := []struct {
+		name        string
+		msgSeq      int
+		mode        mode
+		browse      bool
+		results     []Verse
+		wantApplied bool
+		wantEditing bool
+	}{
+		{name: "current", msgSeq: 2, mode: searchMode, results: results, wantApplied: true, wantEditing: true},
+		{name: "stale", msgSeq: 1, mode: searchMode, results: results, wantEditing: true},
+		{name: "after leaving search", msgSeq: 2, mode: navigationMode, results: results, wantEditing: true},
+		{name: "enter", msgSeq: 2, mode: searchMode, browse: true, results: results, wantApplied: true, wantEditing: false},
+		{name: "enter without results", msgSeq: 2, mode: searchMode, browse: true, wantApplied: true, wantEditing: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel(t)
+			m.openSearch()
+			m.mode = tt.mode
+			m.searchQuery = "light"
+			m.searchSeq = 2
+			m.updateSearchResults(searchResultsMsg{seq: tt.msgSeq, query: "light", results: tt.results, browse: tt.browse})
+			if applied := m.searchedQuery == "light"; applied != tt.wantApplied {
+				t.Errorf("results applied = %v, want %v", applied, tt.wantApplied)
+			}
+			if m.searchEditing != tt.wantEditing {
+				t.Errorf("searchEditing = %v, want %v", m.searchEditing, tt.wantEditing)
+			}
+		})
+	}
+}
+
+func TestSearchEnterRunsInBackground(t *testing.T) {
+	m := newTestModel(t)
+	m.openSearch()
+	for _, r := range "light" {
+		m.handleSearchInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	cmd := m.handleSearchInput(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Enter on a pending query returned no command")
+	}
+	if m.searchResults != nil || !m.searchEditing {
+		t.Fatalf("Enter searched synchronously: results %v, editing %v", m.searchResults, m.searchEditing)
+	}
+
+	updated, _ := m.Update(cmd())
+	m = updated.(model)
+	if len(m.searchResults) == 0 || m.searchEditing {
+		t.Errorf("after the results arrived: results %v, editing %v", m.searchResults, m.searchEditing)
+	}
+}
+
+func TestSearchQueryKeepsSlash(t *testing.T) {
+	m := newTestModel(t)
+	m.openSearch()
+	for _, r := range "and/or" {
+		m.handleSearchInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if m.searchQuery != "and/or" {
+		t.Errorf("searchQuery = %q, want %q", m.searchQuery, "and/or")
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// TranslationInfo describes a translation offered by a TranslationSource.
+type TranslationInfo struct {
+	Name     string
+	Format   string
+	Location string
+}
+
+// TranslationSource provides the translations shown in the app. List
+// should be cheap; the work of parsing a translation belongs in Open,
+// which MultiBibleData only calls when the translation is first needed.
+type TranslationSource interface {
+	List() ([]TranslationInfo, error)
+	Open(name string) (*BibleData, error)
+}
+
+// DirectorySource offers every recognised translation file, USFM
+// directory and USFM zip archive directly inside Dir.
+type DirectorySource struct {
+	Dir   string
+	paths map[string]string
+}
+
+func NewDirectorySource(dir string) *DirectorySource {
+	return &DirectorySource{Dir: dir}
+}
+
+func (s *DirectorySource) List() ([]TranslationInfo, error) {
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read translations dir: %w", err)
+	}
+
+	s.paths = make(map[string]string)
+	var infos []TranslationInfo
+	for _, entry := range entries {
+		path := filepath.Join(s.Dir, entry.Name())
+		format := detectTranslationFormat(path)
+		if format == formatUnknown {
+			continue
+		}
+
+		name := translationName(entry.Name())
+		if _, exists := s.paths[name]; exists {
+			continue
+		}
+		s.paths[name] = path
+		infos = append(infos, TranslationInfo{
+			Name:     name,
+			Format:   format.String(),
+			Location: path,
+		})
+	}
+
+	return infos, nil
+}
+
+func (s *DirectorySource) Open(name string) (*BibleData, error) {
+	if s.paths == nil {
+		if _, err := s.List(); err != nil {
+			return nil, err
+		}
+	}
+
+	path, ok := s.paths[name]
+	if !ok {
+		return nil, fmt.Errorf("translation %q not found in %s", name, s.Dir)
+	}
+	return loadTranslationCached(path)
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestDirectorySourceList(t *testing.T) {
+	const jsonBible = `{"Genesis": {"1": {"1": "In the beginning"}}}`
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  []string
+	}{
+		{
+			name: "every format",
+			files: map[string]string{
+				"KJV_bible.json":        jsonBible,
+				"LUT.zefania.xml":       `<XMLBIBLE biblename="LUT"/>`,
+				"SBL.osis.xml":          `<osis><osisText/></osis>`,
+				"NET.tsv":               "Genesis\t1\t1\tIn the beginning\n",
+				"WEB.csv":               "Genesis,1,1,In the beginning\n",
+				"BSB_usfm/01GEN.usfm":   `\id GEN`,
+				"README.txt":            "Translations go here\n",
+				"config.json":           `{"prewarmTranslations": true}`,
+				"exports/Genesis-1.md":  "# Genesis 1\n",
+				"old/KJV_bible.json":    jsonBible,
+				"bookmarks.json":        `[]`,
+				"notes/John/3-16.md":    "# John 3:16\n",
+				"BSB_usfm/README.md":    "not USFM",
+				"empty_dir/placeholder": "",
+			},
+			want: []string{"BSB USFM", "KJV JSON", "LUT Zefania", "NET TSV", "SBL OSIS", "WEB CSV"},
+		},
+		{
+			name: "duplicate names",
+			files: map[string]string{
+				"KJV.tsv":        "Genesis\t1\t1\tIn the beginning\n",
+				"KJV_bible.json": jsonBible,
+			},
+			want: []string{"KJV TSV"},
+		},
+		{name: "empty", files: map[string]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(dir, filepath.FromSlash(name))
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			source := NewDirectorySource(dir)
+			infos, err := source.List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, info := range infos {
+				got = append(got, info.Name+" "+info.Format)
+				if filepath.Dir(info.Location) != dir {
+					t.Errorf("%s is at %s, want a path in %s", info.Name, info.Location, dir)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("List() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDirectorySourceListMissingDir(t *testing.T) {
+	source := NewDirectorySource(filepath.Join(t.TempDir(), "translations"))
+	infos, err := source.List()
+	if err != nil || len(infos) != 0 {
+		t.Errorf("List() = %v, %v, want no translations and no error", infos, err)
+	}
+	if _, err := source.Open("KJV"); err == nil {
+		t.Error("Open(KJV) succeeded in a missing directory")
+	}
+
+	file := filepath.Join(t.TempDir(), "translations")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewDirectorySource(file).List(); err == nil {
+		t.Error("List() succeeded on a file")
+	}
+}
@@ -19,6 +19,9 @@ type model struct {
 	verses             []Verse
 	searchQuery        string
 	searchResults      []Verse
+	searchEditing      bool
+	searchedQuery      string
+	searchSeq          int
 	mode               mode
 	selected           int
 	scrollOffset       int
@@ -30,6 +33,34 @@ type model struct {
 	textStyle          lipgloss.Style
 	dimStyle           lipgloss.Style
 	zenMode            bool
+	parallel           bool
+	compare            bool
+	loadingTranslation string
+	pendingJump        *verseKey
+	bookmarks          []Bookmark
+	highlights         map[string]string
+	notes              map[verseKey]string
+	notesPane          bool
+	visual             bool
+	visualAnchor       int
+	savedSelected      int
+	savedScrollOffset  int
+	commandInput       string
+	completions        []string
+	completionIndex    int
+	pickerBook         string
+	pickerFilter       string
+	pickerSelected     int
+	pickerScroll       int
+	count              int
+	jumps              []Position
+	jumpIndex          int
+	pendingMark        rune
+	marks              map[string]map[string]Position
+	globalMarks        map[string]Position
+	statusMessage      string
+	clipboard          string
+	clipboardSeq       int
 }
 
 func (m *model) getBibleData() *BibleData {
@@ -41,26 +72,43 @@ type mode int
 const (
 	navigationMode mode = iota
 	searchMode
+	bookmarksMode
+	commandMode
+	pickerMode
+	marksMode
+	helpMode
 )
 
 type AppState struct {
-	CurrentTranslation string `json:"currentTranslation"`
-	CurrentBook        string `json:"currentBook"`
-	CurrentChapter     int    `json:"currentChapter"`
-	Selected           int    `json:"selected"`
-	ScrollOffset       int    `json:"scrollOffset"`
+	CurrentTranslation string                         `json:"currentTranslation"`
+	CurrentBook        string                         `json:"currentBook"`
+	CurrentChapter     int                            `json:"currentChapter"`
+	Selected           int                            `json:"selected"`
+	ScrollOffset       int                            `json:"scrollOffset"`
+	JumpList           []Position                     `json:"jumpList,omitempty"`
+	JumpIndex          int                            `json:"jumpIndex"`
+	Marks              map[string]map[string]Position `json:"marks,omitempty"`
+	GlobalMarks        map[string]Position            `json:"globalMarks,omitempty"`
 }
 
 type Config struct {
-	HighlightColor string `json:"highlightColor"`
-	VerseNumColor  string `json:"verseNumColor"`
-	TextColor      string `json:"textColor"`
-	DimColor       string `json:"dimColor"`
+	HighlightColor       string            `json:"highlightColor"`
+	VerseNumColor        string            `json:"verseNumColor"`
+	TextColor            string            `json:"textColor"`
+	DimColor             string            `json:"dimColor"`
+	PrewarmTranslations  bool              `json:"prewarmTranslations"`
+	Versification        map[string]string `json:"versification,omitempty"`
+	ParallelTranslations []string          `json:"parallelTranslations,omitempty"`
+	HighlightPalette     []HighlightColor  `json:"highlightPalette"`
+	CopyTemplate         string            `json:"copyTemplate"`
+	CopyVerseNumbers     bool              `json:"copyVerseNumbers"`
 }
 
 const (
-	stateFile  = "state.json"
-	configFile = "config.json"
+	stateFile      = "state.json"
+	configFile     = "config.json"
+	bookmarksFile  = "bookmarks.json"
+	highlightsFile = "highlights.json"
 )
 
 func getFilePath(filename string) (string, error) {
@@ -137,10 +185,13 @@ func getDefaultAppState() AppState {
 
 func getDefaultConfig() Config {
 	return Config{
-		HighlightColor: "#cba6f7",
-		VerseNumColor:  "#89b4fa",
-		TextColor:      "#cdd6f4",
-		DimColor:       "#313244",
+		HighlightColor:      "#cba6f7",
+		VerseNumColor:       "#89b4fa",
+		TextColor:           "#cdd6f4",
+		DimColor:            "#313244",
+		PrewarmTranslations: true,
+		HighlightPalette:    defaultHighlightPalette(),
+		CopyTemplate:        defaultCopyTemplate,
 	}
 }
 
@@ -152,6 +203,10 @@ var (
 			Foreground(lipgloss.Color("244")).
 			MarginTop(1).
 			PaddingLeft(1)
+
+	statusStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#f38ba8")).
+			Bold(true)
 )
 
 func initialModel() tea.Model {
@@ -177,9 +232,25 @@ func initialModel() tea.Model {
 		savedState.CurrentTranslation = multiBibleData.translationNames[0]
 	}
 
-	bibleData := multiBibleData.GetCurrentBibleData(savedState.CurrentTranslation)
+	var statusMessage string
+	if err := multiBibleData.SetVersifications(config.Versification); err != nil {
+		statusMessage = err.Error()
+	}
+	bibleData, err := multiBibleData.LoadTranslation(savedState.CurrentTranslation)
+	if err != nil {
+		statusMessage = err.Error()
+		for _, name := range multiBibleData.translationNames {
+			if bibleData, err = multiBibleData.LoadTranslation(name); err == nil {
+				savedState.CurrentTranslation = name
+				break
+			}
+		}
+	}
 	if bibleData == nil {
-		fmt.Fprintf(os.Stderr, "Error: Could not load translation '%s'\n", savedState.CurrentTranslation)
+		for _, loadErr := range multiBibleData.LoadErrors() {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", loadErr)
+		}
+		fmt.Fprintf(os.Stderr, "Error: Could not load any translation\n")
 		os.Exit(1)
 	}
 
@@ -218,6 +289,14 @@ func initialModel() tea.Model {
 		textStyle:          lipgloss.NewStyle().Foreground(lipgloss.Color(config.TextColor)),
 		dimStyle:           lipgloss.NewStyle().Foreground(lipgloss.Color(config.DimColor)),
 		zenMode:            false,
+		statusMessage:      statusMessage,
+		bookmarks:          loadBookmarks(),
+		highlights:         loadHighlights(),
+		notes:              loadNotes(),
+		jumps:              savedState.JumpList,
+		jumpIndex:          max(0, min(savedState.JumpIndex, len(savedState.JumpList))),
+		marks:              savedState.Marks,
+		globalMarks:        savedState.GlobalMarks,
 	}
 }
 
@@ -237,6 +316,10 @@ func (m model) saveCurrentState() {
 		CurrentChapter:     m.currentChapter,
 		Selected:           m.selected,
 		ScrollOffset:       m.scrollOffset,
+		JumpList:           m.jumps,
+		JumpIndex:          m.jumpIndex,
+		Marks:              m.marks,
+		GlobalMarks:        m.globalMarks,
 	}
 	saveState(state)
 }
@@ -272,6 +355,7 @@ func (m *model) navigateToBook(direction int) {
 }
 
 func (m *model) resetVerseView(bibleData *BibleData) {
+	m.visual = false
 	m.verses = bibleData.GetVerses(m.currentBook, m.currentChapter)
 	m.selected = 0
 	m.scrollOffset = 0
@@ -296,12 +380,10 @@ func (m *model) goToNextChapter() {
 	}
 	bibleData := m.getBibleData()
 	m.currentChapter++
-	m.verses = bibleData.GetVerses(m.currentBook, m.currentChapter)
-	if len(m.verses) == 0 {
+	if len(bibleData.GetVerses(m.currentBook, m.currentChapter)) == 0 {
 		m.goToNextBookFirstChapter(bibleData)
 	} else {
-		m.selected = 0
-		m.scrollOffset = 0
+		m.resetVerseView(bibleData)
 	}
 }
 
@@ -365,6 +447,14 @@ func (m *model) getActiveList() (int, bool) {
 	if m.mode == navigationMode {
 		return len(m.verses), true
 	}
+	if m.mode == bookmarksMode && len(m.bookmarks) > 0 {
+		return len(m.bookmarks), true
+	}
+	if m.mode == marksMode {
+		if entries := m.markList(); len(entries) > 0 {
+			return len(entries), true
+		}
+	}
 	return 0, false
 }
 
@@ -400,10 +490,209 @@ func (m *model) pageUp(listLen int) {
 	m.adjustScrollOffset(listLen, visibleVerses)
 }
 
-func (m model) Init() tea.Cmd {
+type translationLoadedMsg struct {
+	name string
+	err  error
+}
+
+// loadTranslationCmd loads a translation off the UI goroutine; the result
+// lands in the MultiBibleData cache and is announced with a
+// translationLoadedMsg.
+func loadTranslationCmd(mbd *MultiBibleData, name string) tea.Cmd {
+	return func() tea.Msg {
+		_, err := mbd.LoadTranslation(name)
+		return translationLoadedMsg{name: name, err: err}
+	}
+}
+
+// neighbourTranslations returns the translations before and after the
+// current one in the t/T rotation.
+func (m model) neighbourTranslations() []string {
+	names := m.multiBibleData.translationNames
+	for i, name := range names {
+		if name == m.currentTranslation {
+			prev := names[(i-1+len(names))%len(names)]
+			next := names[(i+1)%len(names)]
+			if prev == next {
+				return []string{next}
+			}
+			return []string{prev, next}
+		}
+	}
+	return nil
+}
+
+func (m model) prewarmTranslations() tea.Cmd {
+	if !m.config.PrewarmTranslations {
+		return nil
+	}
+	var cmds []tea.Cmd
+	for _, name := range m.neighbourTranslations() {
+		if name != m.currentTranslation && !m.multiBibleData.IsLoaded(name) {
+			cmds = append(cmds, loadTranslationCmd(m.multiBibleData, name))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// cycleTranslation moves through the translation rotation. Loaded
+// translations are switched to immediately; otherwise loading starts in
+// the background and the current translation stays usable until it is
+// ready. Repeated presses while loading step on from the pending one.
+func (m *model) cycleTranslation(direction int) tea.Cmd {
+	names := m.multiBibleData.translationNames
+	from := m.currentTranslation
+	if m.loadingTranslation != "" {
+		from = m.loadingTranslation
+	}
+	m.pendingJump = nil
+
+	currentIndex := 0
+	for i, trans := range names {
+		if trans == from {
+			currentIndex = i
+			break
+		}
+	}
+
+	// Translations that already failed to load are skipped so a broken
+	// file does not block the rest of the rotation.
+	next := m.currentTranslation
+	for step := 1; step < len(names); step++ {
+		candidate := names[((currentIndex+step*direction)%len(names)+len(names))%len(names)]
+		if m.multiBibleData.LoadError(candidate) == nil {
+			next = candidate
+			break
+		}
+	}
+
+	return m.changeTranslation(next)
+}
+
+// changeTranslation switches to translation name, immediately if it is
+// loaded and otherwise once it has loaded in the background.
+func (m *model) changeTranslation(name string) tea.Cmd {
+	m.pendingJump = nil
+	if name == m.currentTranslation {
+		m.loadingTranslation = ""
+		return nil
+	}
+	if m.multiBibleData.IsLoaded(name) {
+		m.loadingTranslation = ""
+		m.switchTranslation(name)
+		return m.prewarmTranslations()
+	}
+
+	m.loadingTranslation = name
+	return loadTranslationCmd(m.multiBibleData, name)
+}
+
+// switchTranslation shows the selected passage in translation name,
+// mapping between the verse numbering of the two translations. If the
+// passage does not exist there, the nearest verse is selected and the
+// status line says so.
+func (m *model) switchTranslation(name string) {
+	from := m.currentTranslation
+	ref := m.currentVerseKey()
+	m.currentTranslation = name
+
+	target := m.multiBibleData.MapVerse(ref, from, name)
+	verse, ok := m.goToVerse(target)
+	if !ok {
+		m.statusMessage = fmt.Sprintf("%s is not in %s", ref.book, name)
+		return
+	}
+	if verse.Chapter != target.chapter || verse.Verse != target.verse {
+		m.statusMessage = fmt.Sprintf("%s %d:%d is not in %s, showing %s %d:%d",
+			ref.book, ref.chapter, ref.verse, name, verse.Book, verse.Chapter, verse.Verse)
+	}
+}
+
+// goToVerse selects the verse of the current translation nearest to ref
+// and returns it. If the translation lacks the book it shows the first
+// book instead and returns false.
+func (m *model) goToVerse(ref verseKey) (Verse, bool) {
+	bibleData := m.getBibleData()
+	m.mode = navigationMode
+	m.visual = false
+	ref.book = bibleData.translationBook(ref.book)
+	verse, ok := bibleData.nearestVerse(ref)
+	if !ok {
+		m.currentBook = bibleData.GetBooks()[0]
+		m.currentChapter = 1
+		m.resetVerseView(bibleData)
+		return Verse{}, false
+	}
+
+	m.currentBook = verse.Book
+	m.currentChapter = verse.Chapter
+	m.verses = bibleData.GetVerses(verse.Book, verse.Chapter)
+	m.selected = 0
+	for i, v := range m.verses {
+		if v.Verse == verse.Verse {
+			m.selected = i
+			break
+		}
+	}
+	m.adjustScrollOffset(len(m.verses), m.getVisibleVerses())
+	return verse, true
+}
+
+// jumpTo shows ref in translation, loading the translation in the
+// background first if needed. The status line reports a reference that
+// could not be found exactly.
+func (m *model) jumpTo(translation string, ref verseKey) tea.Cmd {
+	if translation != m.currentTranslation && !contains(m.multiBibleData.translationNames, translation) {
+		m.statusMessage = fmt.Sprintf("Translation %s is no longer available", translation)
+		return nil
+	}
+	if translation != m.currentTranslation && !m.multiBibleData.IsLoaded(translation) {
+		m.loadingTranslation = translation
+		m.pendingJump = &ref
+		return loadTranslationCmd(m.multiBibleData, translation)
+	}
+
+	changed := translation != m.currentTranslation
+	m.currentTranslation = translation
+	verse, ok := m.goToVerse(ref)
+	switch {
+	case !ok:
+		m.statusMessage = fmt.Sprintf("%s is not in %s", ref.book, translation)
+	case verse.Chapter != ref.chapter || verse.Verse != ref.verse:
+		m.statusMessage = fmt.Sprintf("%s %d:%d is not in %s, showing %s %d:%d",
+			ref.book, ref.chapter, ref.verse, translation, verse.Book, verse.Chapter, verse.Verse)
+	}
+	if changed {
+		return tea.Batch(m.prewarmTranslations(), m.loadParallelColumns())
+	}
 	return nil
 }
 
+// currentVerseKey returns the reference of the selected verse, or the
+// start of the current chapter if it has no verses.
+func (m model) currentVerseKey() verseKey {
+	if m.selected < len(m.verses) {
+		verse := m.verses[m.selected]
+		return verseKey{book: verse.Book, chapter: verse.Chapter, verse: verse.Verse}
+	}
+	return verseKey{book: m.currentBook, chapter: m.currentChapter, verse: 1}
+}
+
+func (m model) headerText() string {
+	header := fmt.Sprintf("%s %s %d", m.currentTranslation, m.currentBook, m.currentChapter)
+	if m.loadingTranslation != "" {
+		header += fmt.Sprintf(" (loading %s…)", m.loadingTranslation)
+	}
+	if m.visual {
+		header += fmt.Sprintf(" — VISUAL %s", formatPassage(m.selectedVerses()))
+	}
+	return header
+}
+
+func (m model) Init() tea.Cmd {
+	return m.prewarmTranslations()
+}
+
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
@@ -412,35 +701,109 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.scrollOffset > 0 && len(m.verses) > 0 {
 			m.adjustScrollOffset(len(m.verses), m.getVisibleVerses())
 		}
+		if m.mode == pickerMode {
+			m.scrollPicker()
+		}
+		return m, nil
+	case translationLoadedMsg:
+		// Background loads nobody is waiting for, such as prewarming, keep
+		// their errors in LoadErrors rather than replacing the status line.
+		if msg.name != m.loadingTranslation {
+			return m, nil
+		}
+		m.loadingTranslation = ""
+		jump := m.pendingJump
+		m.pendingJump = nil
+		if msg.err != nil {
+			m.statusMessage = msg.err.Error()
+			return m, nil
+		}
+		if jump != nil {
+			return m, m.jumpTo(msg.name, *jump)
+		}
+		m.switchTranslation(msg.name)
+		return m, tea.Batch(m.prewarmTranslations(), m.loadParallelColumns())
+	case searchDebounceMsg:
+		if msg.seq != m.searchSeq || m.mode != searchMode {
+			return m, nil
+		}
+		return m, searchCmd(m.getBibleData(), msg.seq, m.searchQuery, false)
+	case searchResultsMsg:
+		m.updateSearchResults(msg)
+		return m, nil
+	case noteEditedMsg:
+		m.updateNote(msg)
+		return m, nil
+	case clipboardSentMsg:
+		m.clipboardSent(msg)
+		return m, nil
+	case tea.MouseMsg:
+		if m.mode == pickerMode {
+			return m, m.handlePickerMouse(msg)
+		}
 		return m, nil
 	case tea.KeyMsg:
+		m.statusMessage = ""
+		if m.mode == commandMode {
+			return m, m.handleCommandKey(msg)
+		}
+		if m.mode == pickerMode {
+			return m, m.handlePickerKey(msg)
+		}
+		if m.mode == helpMode {
+			m.handleHelpKey(msg)
+			return m, nil
+		}
+		if m.pendingMark != 0 {
+			return m, m.handleMarkKey(msg)
+		}
+		if m.mode == searchMode && m.searchEditing {
+			return m, m.handleSearchInput(msg)
+		}
+		if msg.Type == tea.KeyRunes && len(msg.Runes) == 1 && m.addCountDigit(msg.Runes[0]) {
+			return m, nil
+		}
+		count, hasCount := m.takeCount()
+		if hasCount && msg.Type == tea.KeyEsc {
+			return m, nil
+		}
 		switch msg.Type {
 		case tea.KeyCtrlC, tea.KeyEsc:
 			if m.mode == searchMode {
-				m.mode = navigationMode
-				m.searchQuery = ""
-				m.searchResults = nil
+				m.closeSearch()
+				return m, nil
+			}
+			if m.mode == bookmarksMode {
+				m.closeBookmarks()
+				return m, nil
+			}
+			if m.mode == marksMode {
+				m.closeMarks()
+				return m, nil
+			}
+			if m.visual {
+				m.visual = false
 				return m, nil
 			}
 			m.saveCurrentState()
 			return m, tea.Quit
 
 		case tea.KeyEnter:
+			if m.mode == bookmarksMode {
+				return m, m.openSelectedBookmark()
+			}
+			if m.mode == marksMode {
+				return m, m.openSelectedMark()
+			}
 			if m.mode == searchMode {
-				if len(m.searchResults) == 0 && m.searchQuery != "" {
-					bibleData := m.getBibleData()
-					m.searchResults = bibleData.Search(m.searchQuery)
-					m.selected = 0
-					m.scrollOffset = 0
-				} else if len(m.searchResults) > 0 && m.selected < len(m.searchResults) {
+				if m.selected < len(m.searchResults) {
 					result := m.searchResults[m.selected]
+					m.closeSearch()
+					m.selected = m.savedSelected
+					m.recordJump()
 					m.currentBook = result.Book
 					m.currentChapter = result.Chapter
-					bibleData := m.getBibleData()
-					m.verses = bibleData.GetVerses(result.Book, result.Chapter)
-					m.mode = navigationMode
-					m.selected = 0
-					m.scrollOffset = 0
+					m.resetVerseView(m.getBibleData())
 
 					for i, verse := range m.verses {
 						if verse.Verse == result.Verse {
@@ -452,47 +815,24 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 		case tea.KeyBackspace:
-			if m.mode == searchMode && len(m.searchResults) == 0 && len(m.searchQuery) > 0 {
-				m.searchQuery = m.searchQuery[:len(m.searchQuery)-1]
-			}
-
-		case tea.KeySpace:
-			if m.mode == searchMode && len(m.searchResults) == 0 {
-				m.searchQuery += " "
+			if m.mode == searchMode {
+				m.searchEditing = true
+				return m, m.handleSearchInput(msg)
 			}
 
 		case tea.KeyRunes:
 			if len(msg.Runes) > 0 {
 				r := msg.Runes[0]
 
-				if m.mode == searchMode && len(m.searchResults) == 0 {
-					switch r {
-					case '/':
-						m.searchQuery = ""
-						m.searchResults = nil
-						m.selected = 0
-						m.scrollOffset = 0
-					default:
-						m.searchQuery += string(msg.Runes)
-					}
-					return m, nil
-				}
-
 				switch r {
 				case '/':
 					if m.mode == navigationMode {
-						m.mode = searchMode
-						m.searchQuery = ""
-						m.searchResults = nil
-						m.selected = 0
+						m.openSearch()
 					} else if m.mode == searchMode {
-						m.searchQuery = ""
-						m.searchResults = nil
-						m.selected = 0
-						m.scrollOffset = 0
+						m.searchEditing = true
 					}
 				case 'g':
-					if m.mode == navigationMode || (m.mode == searchMode && len(m.searchResults) > 0) {
+					if m.mode == navigationMode || m.mode == bookmarksMode || m.mode == marksMode || m.mode == searchMode {
 						if m.selected > 0 {
 							m.selected = 0
 							m.scrollOffset = 0
@@ -500,7 +840,9 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				case 'G':
 					listLen, ok := m.getActiveList()
-					if ok {
+					if hasCount {
+						m.selectNumber(count)
+					} else if ok {
 						m.selected = listLen - 1
 						visibleVerses := m.getVisibleVerses()
 						if m.selected >= visibleVerses {
@@ -509,74 +851,136 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				case 'b':
 					if m.mode == navigationMode {
-						m.goToPreviousBook()
+						repeat(count, m.goToPreviousBook)
 					}
 				case 'w':
 					if m.mode == navigationMode {
-						m.goToNextBook()
+						repeat(count, m.goToNextBook)
 					}
 				case 'k':
-					m.handleMovement("up")
+					repeat(count, func() { m.handleMovement("up") })
 				case 'j':
-					m.handleMovement("down")
+					repeat(count, func() { m.handleMovement("down") })
 				case 'h':
 					if m.mode == navigationMode {
-						m.goToPreviousChapter()
+						repeat(count, m.goToPreviousChapter)
 					}
 				case 'l':
 					if m.mode == navigationMode {
-						m.goToNextChapter()
+						repeat(count, m.goToNextChapter)
 					}
 				case 't', 'T':
 					if m.mode == navigationMode {
-						currentIndex := -1
-						for i, trans := range m.multiBibleData.translationNames {
-							if trans == m.currentTranslation {
-								currentIndex = i
-								break
-							}
-						}
-
-						var nextIndex int
-						if msg.Runes[0] == 't' {
-							nextIndex = (currentIndex + 1) % len(m.multiBibleData.translationNames)
-						} else {
-							nextIndex = currentIndex - 1
-							if nextIndex < 0 {
-								nextIndex = len(m.multiBibleData.translationNames) - 1
-							}
-						}
-
-						m.currentTranslation = m.multiBibleData.translationNames[nextIndex]
-						bibleData := m.getBibleData()
-						books := bibleData.GetBooks()
-						if !contains(books, m.currentBook) {
-							m.currentBook = books[0]
-							m.currentChapter = 1
+						direction := 1
+						if r == 'T' {
+							direction = -1
 						}
-						m.resetVerseView(bibleData)
+						cmd := m.cycleTranslation(direction)
+						return m, tea.Batch(cmd, m.loadParallelColumns())
 					}
 				case 'z':
 					if m.mode == navigationMode {
 						m.zenMode = !m.zenMode
 					}
+				case 'p':
+					if m.mode == navigationMode {
+						m.parallel = !m.parallel
+						return m, m.loadParallelColumns()
+					}
+				case 'c':
+					if m.mode == navigationMode {
+						m.compare = !m.compare
+						if m.compare && m.compareTranslation() == "" {
+							m.compare = false
+							m.statusMessage = "Compare needs a second translation"
+						}
+						return m, m.loadParallelColumns()
+					}
+				case 'H':
+					if m.mode == navigationMode {
+						m.cycleHighlight(m.selectedVerses())
+						m.visual = false
+					} else if m.mode == searchMode && m.selected < len(m.searchResults) {
+						m.cycleHighlight(m.searchResults[m.selected : m.selected+1])
+					}
+				case 'y':
+					if m.mode == navigationMode {
+						verses := m.selectedVerses()
+						m.visual = false
+						return m, m.yank(verses)
+					} else if m.mode == searchMode && m.selected < len(m.searchResults) {
+						return m, m.yank(m.searchResults[m.selected : m.selected+1])
+					}
+				case 'v', 'V':
+					if m.mode == navigationMode {
+						m.toggleVisual()
+					}
+				case 'n':
+					if m.mode == navigationMode {
+						verses := m.selectedVerses()
+						m.visual = false
+						return m, m.editNote(verses)
+					}
+				case 'N':
+					if m.mode == navigationMode {
+						m.notesPane = !m.notesPane
+					}
+				case 'B':
+					if m.mode == navigationMode {
+						m.toggleBookmark(m.selectedVerses())
+						m.visual = false
+					}
+				case 'L':
+					if m.mode == navigationMode {
+						m.openBookmarks()
+					} else if m.mode == bookmarksMode {
+						m.closeBookmarks()
+					}
+				case ':':
+					if m.mode == navigationMode {
+						m.openCommandLine()
+					}
+				case '?':
+					if m.mode == navigationMode {
+						m.openHelp()
+					}
+				case 'o':
+					if m.mode == navigationMode {
+						return m, m.openPicker()
+					}
+				case 'm', '\'':
+					if m.mode == navigationMode {
+						m.startMark(r)
+					}
+				case 'd', 'x':
+					if m.mode == bookmarksMode {
+						m.deleteSelectedBookmark()
+					} else if m.mode == marksMode {
+						m.deleteSelectedMark()
+					}
 				case 'q':
+					if m.mode == bookmarksMode {
+						m.closeBookmarks()
+					}
+					if m.mode == marksMode {
+						m.closeMarks()
+					}
 					m.saveCurrentState()
 					return m, tea.Quit
 				}
 			}
 
 		case tea.KeyUp:
-			m.handleMovement("up")
+			repeat(count, func() { m.handleMovement("up") })
 
 		case tea.KeyDown:
-			m.handleMovement("down")
+			repeat(count, func() { m.handleMovement("down") })
 
 		case tea.KeyLeft:
-			m.goToPreviousChapter()
+			repeat(count, m.goToPreviousChapter)
 
 		case tea.KeyRight:
-			m.goToNextChapter()
+			repeat(count, m.goToNextChapter)
 
 		case tea.KeyPgUp:
 			if m.mode == navigationMode {
@@ -588,6 +992,16 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.goToNextBook()
 			}
 
+		case tea.KeyCtrlO:
+			if m.mode == navigationMode {
+				return m, m.jumpBack()
+			}
+
+		case tea.KeyTab:
+			if m.mode == navigationMode {
+				return m, m.jumpForward()
+			}
+
 		case tea.KeyCtrlD:
 			m.handleMovement("pageDown")
 
@@ -600,20 +1014,95 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m model) View() string {
+	if m.clipboard != "" {
+		// The clipboard sequence goes out with the frame; see clipboardHold.
+		frame := m
+		frame.clipboard = ""
+		return m.clipboard + frame.View()
+	}
+	if m.mode == commandMode {
+		// The command line replaces the help line of the chapter view.
+		chapterView := m
+		chapterView.mode = navigationMode
+		view := chapterView.View()
+		return view[:strings.LastIndex(view, "\n")+1] + m.renderCommandLine()
+	}
+
 	var content strings.Builder
 
-	helpText := "j/k: Navigate • h/l: Chapter • b/w: Book • t/T: Translation • g/G: Top/Bottom • Ctrl+d/u: Half page • /: Search • z: Zen mode • q: Quit"
+	helpText := "j/k: Navigate • h/l: Chapter • /: Search • :: Command • ?: Help • q: Quit"
+	if m.mode == navigationMode && m.visual {
+		helpText = "VISUAL • j/k: Extend • g/G: Top/Bottom • y: Copy • H: Highlight • B: Bookmark • n: Note • v/Esc: Cancel"
+	}
+	if m.mode == pickerMode {
+		helpText = "Type to filter • ←/→/↑/↓: Select • Enter/Click: Open • Esc: Back"
+	}
+	if m.mode == bookmarksMode {
+		helpText = "j/k: Navigate • g/G: Top/Bottom • Enter: Open • d: Delete • Esc/L: Back • q: Quit"
+	}
+	if m.mode == marksMode {
+		helpText = "j/k: Navigate • g/G: Top/Bottom • Enter: Jump • d: Delete • Esc: Back • q: Quit"
+	}
+	if m.mode == helpMode {
+		helpText = "?/Esc/q: Back"
+	}
 	if m.mode == searchMode {
-		if len(m.searchResults) > 0 {
-			helpText = "j/k: Navigate • g/G: Top/Bottom • Ctrl+d/u: Half page • Enter: Select • /: New search • Esc: Back"
+		if !m.searchEditing {
+			helpText = "j/k: Navigate • g/G: Top/Bottom • Ctrl+d/u: Half page • Enter: Select • y: Copy • H: Highlight • /: Edit search • Esc: Back"
 		} else {
-			helpText = "Type to search • Enter: Execute • Esc: Back • q: Quit"
+			helpText = "Type to search • ↑/↓: Select • Enter: Browse results • Ctrl+u: Clear • Esc: Back"
 		}
 	}
 
 	if m.mode == navigationMode {
-		if m.zenMode {
-			header := m.bookStyle.Render(fmt.Sprintf("%s %s %d", m.currentTranslation, m.currentBook, m.currentChapter))
+		if m.compare {
+			header := m.bookStyle.Render(fmt.Sprintf("%s (compared with %s)", m.headerText(), m.compareTranslation()))
+			content.WriteString(m.centerText(header))
+			content.WriteString("\n\n")
+
+			visibleVerses := m.getVisibleVerses()
+			m.adjustScrollOffset(len(m.verses), visibleVerses)
+			end := min(len(m.verses), m.scrollOffset+visibleVerses)
+
+			linesUsed := 3
+			for i := m.scrollOffset; i < end; i++ {
+				verse := m.verses[i]
+				verseNumStr := m.verseNumStyle.Render(fmt.Sprintf("%3d", verse.Verse))
+				linesUsed += m.renderCompareVerse(&content, verse, m.isSelected(i), verseNumStr)
+			}
+
+			remainingLines := m.height - linesUsed
+			if remainingLines > 0 {
+				content.WriteString(strings.Repeat("\n", remainingLines))
+			}
+
+			content.WriteString(m.renderHelpLine(helpText))
+		} else if m.parallel {
+			header := m.bookStyle.Render(m.headerText())
+			content.WriteString(m.centerText(header))
+			content.WriteString("\n")
+			content.WriteString(m.renderParallelTitles())
+			content.WriteString("\n")
+
+			visibleVerses := m.getVisibleVerses()
+			m.adjustScrollOffset(len(m.verses), visibleVerses)
+			end := min(len(m.verses), m.scrollOffset+visibleVerses)
+
+			linesUsed := 3
+			for i := m.scrollOffset; i < end; i++ {
+				verse := m.verses[i]
+				verseNumStr := m.verseNumStyle.Render(fmt.Sprintf("%3d", verse.Verse))
+				linesUsed += m.renderParallelVerse(&content, verse, m.isSelected(i), verseNumStr)
+			}
+
+			remainingLines := m.height - linesUsed
+			if remainingLines > 0 {
+				content.WriteString(strings.Repeat("\n", remainingLines))
+			}
+
+			content.WriteString(m.renderHelpLine(helpText))
+		} else if m.zenMode {
+			header := m.bookStyle.Render(m.headerText())
 			content.WriteString(m.centerText(header))
 			content.WriteString("\n\n")
 
@@ -665,7 +1154,7 @@ func (m model) View() string {
 					verseNumStr := m.verseNumStyle.Render(fmt.Sprintf("%3d", verse.Verse))
 					paddingWidth := 6
 
-					m.renderVerseZen(&content, verse, i == m.selected, verseNumStr, paddingWidth)
+					m.renderVerseZen(&content, verse, m.isSelected(i), verseNumStr, paddingWidth)
 
 					if i < endIdx-1 {
 						content.WriteString("\n")
@@ -679,10 +1168,9 @@ func (m model) View() string {
 				content.WriteString("\n")
 			}
 
-			helpStyled := lipgloss.NewStyle().Foreground(lipgloss.Color(m.config.VerseNumColor)).Render(helpText)
-			content.WriteString(m.centerText(helpStyled))
+			content.WriteString(m.renderHelpLine(helpText))
 		} else {
-			header := m.bookStyle.Render(fmt.Sprintf("%s %s %d", m.currentTranslation, m.currentBook, m.currentChapter))
+			header := m.bookStyle.Render(m.headerText())
 			content.WriteString(m.centerText(header))
 			content.WriteString("\n\n")
 
@@ -694,7 +1182,8 @@ func (m model) View() string {
 			for i := m.scrollOffset; i < end; i++ {
 				verse := m.verses[i]
 				verseNumStr := m.verseNumStyle.Render(fmt.Sprintf("%3d", verse.Verse))
-				linesUsed += m.renderVerse(&content, verse, i == m.selected, verseNumStr, verseTextPadding)
+				linesUsed += m.renderVerse(&content, verse, m.isSelected(i), verseNumStr, verseTextPadding)
+				linesUsed += m.renderNote(&content, verse)
 			}
 
 			remainingLines := m.height - linesUsed
@@ -702,74 +1191,93 @@ func (m model) View() string {
 				content.WriteString(strings.Repeat("\n", remainingLines))
 			}
 
-			helpStyled := lipgloss.NewStyle().Foreground(lipgloss.Color(m.config.VerseNumColor)).Render(helpText)
-			content.WriteString(m.centerText(helpStyled))
+			content.WriteString(m.renderHelpLine(helpText))
 		}
-	} else {
-		if len(m.searchResults) > 0 {
-			header := m.bookStyle.Render(fmt.Sprintf("Search: %s (%d results)", m.searchQuery, len(m.searchResults)))
-			content.WriteString(m.centerText(header))
-			content.WriteString("\n\n")
+	} else if m.mode == pickerMode {
+		header := m.bookStyle.Render(m.pickerHeader())
+		content.WriteString(m.centerText(header))
+		content.WriteString("\n\n")
 
-			availableHeight := m.height - 6
+		linesUsed := 3 + m.renderPicker(&content)
 
-			if availableHeight < 5 {
-				availableHeight = 5
-			}
+		remainingLines := m.height - linesUsed
+		if remainingLines > 0 {
+			content.WriteString(strings.Repeat("\n", remainingLines))
+		}
 
-			m.clampSelectedIndex(len(m.searchResults))
+		content.WriteString(m.renderHelpLine(helpText))
+	} else if m.mode == helpMode {
+		header := m.bookStyle.Render("Keys")
+		content.WriteString(m.centerText(header))
+		content.WriteString("\n\n")
 
-			_, visibleCount := m.calculateVisibleSearchResults(availableHeight)
+		linesUsed := 3 + m.renderHelp(&content)
 
-			if m.selected >= m.scrollOffset+visibleCount {
-				m.scrollOffset = m.selected
-				testHeight := m.calculateSearchResultHeight(m.searchResults[m.selected])
+		remainingLines := m.height - linesUsed
+		if remainingLines > 0 {
+			content.WriteString(strings.Repeat("\n", remainingLines))
+		}
 
-				for m.scrollOffset > 0 && testHeight < availableHeight {
-					prevHeight := m.calculateSearchResultHeight(m.searchResults[m.scrollOffset-1])
-					if testHeight+prevHeight <= availableHeight {
-						m.scrollOffset--
-						testHeight += prevHeight
-					} else {
-						break
-					}
-				}
+		content.WriteString(m.renderHelpLine(helpText))
+	} else if m.mode == marksMode {
+		header := m.bookStyle.Render(fmt.Sprintf("Marks (%d)", len(m.markList())))
+		content.WriteString(m.centerText(header))
+		content.WriteString("\n\n")
 
-				_, visibleCount = m.calculateVisibleSearchResults(availableHeight)
-			}
+		linesUsed := 3 + m.renderMarks(&content)
 
-			if m.selected < m.scrollOffset {
-				m.scrollOffset = m.selected
-				_, visibleCount = m.calculateVisibleSearchResults(availableHeight)
-			}
+		remainingLines := m.height - linesUsed
+		if remainingLines > 0 {
+			content.WriteString(strings.Repeat("\n", remainingLines))
+		}
 
-			end := min(len(m.searchResults), m.scrollOffset+visibleCount)
+		content.WriteString(m.renderHelpLine(helpText))
+	} else if m.mode == bookmarksMode {
+		header := m.bookStyle.Render(fmt.Sprintf("Bookmarks (%d)", len(m.bookmarks)))
+		content.WriteString(m.centerText(header))
+		content.WriteString("\n\n")
 
-			linesUsed := 3
-			for i := m.scrollOffset; i < end; i++ {
-				result := m.searchResults[i]
-				reference := truncateText(fmt.Sprintf("%s %d:%d", result.Book, result.Chapter, result.Verse), 20)
-				verseNumStr := m.verseNumStyle.Render(fmt.Sprintf("%-20s", reference))
-				linesUsed += m.renderVerse(&content, result, i == m.selected, verseNumStr, searchTextPadding)
+		linesUsed := 3 + m.renderBookmarks(&content)
+
+		remainingLines := m.height - linesUsed
+		if remainingLines > 0 {
+			content.WriteString(strings.Repeat("\n", remainingLines))
+		}
+
+		content.WriteString(m.renderHelpLine(helpText))
+	} else {
+		if len(m.searchResults) > 0 {
+			header := m.bookStyle.Render(fmt.Sprintf("Search: %s (%d results)", m.searchInput(), len(m.searchResults)))
+			if m.searchPending() {
+				header = m.bookStyle.Render(fmt.Sprintf("Search: %s (searching…)", m.searchInput()))
 			}
+			content.WriteString(m.centerText(header))
+			content.WriteString("\n\n")
+
+			linesUsed := 3 + m.renderVerseList(&content, m.searchResults, func(i int) string {
+				result := m.searchResults[i]
+				return fmt.Sprintf("%s %d:%d", result.Book, result.Chapter, result.Verse)
+			})
 
 			remainingLines := m.height - linesUsed
 			if remainingLines > 0 {
 				content.WriteString(strings.Repeat("\n", remainingLines))
 			}
 
-			helpStyled := lipgloss.NewStyle().Foreground(lipgloss.Color(m.config.VerseNumColor)).Render(helpText)
-			content.WriteString(m.centerText(helpStyled))
+			content.WriteString(m.renderHelpLine(helpText))
 		} else {
-			header := m.bookStyle.Render(fmt.Sprintf("Search: %s", m.searchQuery))
+			header := m.bookStyle.Render(fmt.Sprintf("Search: %s", m.searchInput()))
 			content.WriteString(m.centerText(header))
 			content.WriteString("\n\n")
 
 			var promptText string
-			if m.searchQuery != "" {
-				promptText = "Press Enter to search"
-			} else {
+			switch {
+			case strings.TrimSpace(m.searchQuery) == "":
 				promptText = "Type to search..."
+			case m.searchPending():
+				promptText = "Searching..."
+			default:
+				promptText = "No results"
 			}
 			content.WriteString(m.centerText(promptText))
 
@@ -778,14 +1286,23 @@ func (m model) View() string {
 				content.WriteString(strings.Repeat("\n", remainingLines))
 			}
 
-			helpStyled := lipgloss.NewStyle().Foreground(lipgloss.Color(m.config.VerseNumColor)).Render(helpText)
-			content.WriteString(m.centerText(helpStyled))
+			content.WriteString(m.renderHelpLine(helpText))
 		}
 	}
 
 	return content.String()
 }
 
+// renderHelpLine renders the bottom line: the key help, or the status
+// message when there is one.
+func (m model) renderHelpLine(helpText string) string {
+	if m.statusMessage != "" {
+		return m.centerText(statusStyle.Render(truncateText(m.statusMessage, max(10, m.width-2))))
+	}
+	helpStyled := lipgloss.NewStyle().Foreground(lipgloss.Color(m.config.VerseNumColor)).Render(fitHelpText(helpText, max(10, m.width-2)))
+	return m.centerText(helpStyled)
+}
+
 func (m *model) clampSelectedIndex(maxLen int) {
 	m.selected = max(0, min(maxLen-1, m.selected))
 }
@@ -838,7 +1355,13 @@ const (
 )
 
 func (m model) calculateVerseHeight(verse Verse) int {
-	return m.calculateTextHeight(verse.Text, verseTextPadding)
+	if m.compare {
+		return m.compareVerseHeight(verse)
+	}
+	if m.parallel {
+		return m.parallelVerseHeight(verse)
+	}
+	return m.calculateTextHeight(verse.Text, verseTextPadding) + m.noteHeight(verse)
 }
 
 func (m model) calculateSearchResultHeight(result Verse) int {
@@ -891,7 +1414,11 @@ func (m model) renderVerse(content *strings.Builder, verse Verse, isSelected boo
 	} else {
 		content.WriteString(" ")
 	}
-	content.WriteByte(' ')
+	if m.hasNote(verse) {
+		content.WriteString(m.verseNumStyle.Render("•"))
+	} else {
+		content.WriteByte(' ')
+	}
 	content.WriteString(verseNumStr)
 	content.WriteByte(' ')
 
@@ -899,7 +1426,7 @@ func (m model) renderVerse(content *strings.Builder, verse Verse, isSelected boo
 	verseLines := wrapVerseText(verse.Text, textWidth)
 
 	if len(verseLines) > 0 {
-		content.WriteString(m.textStyle.Render(verseLines[0]))
+		content.WriteString(m.verseTextStyle(verse).Render(verseLines[0]))
 	}
 	content.WriteByte('\n')
 	linesUsed := 1
@@ -908,7 +1435,7 @@ func (m model) renderVerse(content *strings.Builder, verse Verse, isSelected boo
 		padding := strings.Repeat(" ", paddingWidth)
 		for _, line := range verseLines[1:] {
 			content.WriteString(padding)
-			content.WriteString(m.textStyle.Render(line))
+			content.WriteString(m.verseTextStyle(verse).Render(line))
 			content.WriteByte('\n')
 			linesUsed++
 		}
@@ -918,9 +1445,56 @@ func (m model) renderVerse(content *strings.Builder, verse Verse, isSelected boo
 	return linesUsed + 1
 }
 
-func (m *model) calculateVisibleSearchResults(availableHeight int) (linesUsed, visibleCount int) {
-	for i := m.scrollOffset; i < len(m.searchResults) && linesUsed < availableHeight; i++ {
-		resultHeight := m.calculateSearchResultHeight(m.searchResults[i])
+// renderVerseList renders the part of a list of verses that fits on
+// screen, scrolled to keep the selection visible, with each verse labelled
+// by reference(i). It returns the number of lines used.
+func (m *model) renderVerseList(content *strings.Builder, items []Verse, reference func(int) string) int {
+	availableHeight := m.height - 6
+
+	if availableHeight < 5 {
+		availableHeight = 5
+	}
+
+	m.clampSelectedIndex(len(items))
+
+	_, visibleCount := m.calculateVisibleResults(items, availableHeight)
+
+	if m.selected >= m.scrollOffset+visibleCount {
+		m.scrollOffset = m.selected
+		testHeight := m.calculateSearchResultHeight(items[m.selected])
+
+		for m.scrollOffset > 0 && testHeight < availableHeight {
+			prevHeight := m.calculateSearchResultHeight(items[m.scrollOffset-1])
+			if testHeight+prevHeight <= availableHeight {
+				m.scrollOffset--
+				testHeight += prevHeight
+			} else {
+				break
+			}
+		}
+
+		_, visibleCount = m.calculateVisibleResults(items, availableHeight)
+	}
+
+	if m.selected < m.scrollOffset {
+		m.scrollOffset = m.selected
+		_, visibleCount = m.calculateVisibleResults(items, availableHeight)
+	}
+
+	end := min(len(items), m.scrollOffset+visibleCount)
+
+	linesUsed := 0
+	for i := m.scrollOffset; i < end; i++ {
+		item := items[i]
+		verseNumStr := m.verseNumStyle.Render(fmt.Sprintf("%-20s", truncateText(reference(i), 20)))
+		linesUsed += m.renderVerse(content, item, i == m.selected, verseNumStr, searchTextPadding)
+	}
+	return linesUsed
+}
+
+func (m *model) calculateVisibleResults(items []Verse, availableHeight int) (linesUsed, visibleCount int) {
+	for i := m.scrollOffset; i < len(items) && linesUsed < availableHeight; i++ {
+		resultHeight := m.calculateSearchResultHeight(items[i])
 		if linesUsed+resultHeight <= availableHeight {
 			linesUsed += resultHeight
 			visibleCount++
@@ -970,7 +1544,9 @@ func (m model) renderVerseZen(content *strings.Builder, verse Verse, isSelected
 
 	var style lipgloss.Style
 	if isSelected {
-		style = m.textStyle
+		style = m.verseTextStyle(verse)
+	} else if color, ok := m.highlightOf(verse); ok {
+		style = lipgloss.NewStyle().Foreground(lipgloss.Color(color.Color)).Faint(true)
 	} else {
 		style = m.dimStyle
 	}
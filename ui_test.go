@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+// uiTestBible has a gap at Genesis 1:4, for moves to missing verses.
+const uiTestBible = `{
+	"Genesis": {
+		"1": {"1": "In the beginning God created", "2": "And the earth was without form", "3": "And God said, Let there be light", "5": "And God called the light Day"},
+		"2": {"1": "Thus the heavens and the earth were finished"}
+	},
+	"John": {"3": {"16": "For God so loved the world", "17": "For God sent not his Son"}}
+}`
+
+// newTestModel returns a model reading Genesis 1 of a small translation
+// named TST, without touching the config directory.
+func newTestModel(t *testing.T) model {
+	t.Helper()
+	bd, err := NewBibleData([]byte(uiTestBible))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return model{
+		multiBibleData: &MultiBibleData{
+			translations:     map[string]*BibleData{"TST": bd},
+			translationNames: []string{"TST"},
+			translationInfo:  make(map[string]TranslationInfo),
+			loadErrors:       make(map[string]error),
+			versifications:   make(map[string]*versification),
+		},
+		currentTranslation: "TST",
+		currentBook:        "Genesis",
+		currentChapter:     1,
+		verses:             bd.GetVerses("Genesis", 1),
+		mode:               navigationMode,
+		height:             24,
+		width:              80,
+		config:             getDefaultConfig(),
+	}
+}
+
+func TestTranslationLoadedMsgStatus(t *testing.T) {
+	loadErr := errors.New("translation BAD: syntax error")
+	tests := []struct {
+		name       string
+		loading    string
+		msg        translationLoadedMsg
+		wantStatus string
+	}{
+		{name: "prewarm failed", msg: translationLoadedMsg{name: "BAD", err: loadErr}, wantStatus: "Copied Genesis 1:1"},
+		{name: "other translation failed while loading", loading: "KJV", msg: translationLoadedMsg{name: "BAD", err: loadErr}, wantStatus: "Copied Genesis 1:1"},
+		{name: "selected translation failed", loading: "BAD", msg: translationLoadedMsg{name: "BAD", err: loadErr}, wantStatus: loadErr.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel(t)
+			m.statusMessage = "Copied Genesis 1:1"
+			m.loadingTranslation = tt.loading
+			updated, _ := m.Update(tt.msg)
+			if got := updated.(model).statusMessage; got != tt.wantStatus {
+				t.Errorf("statusMessage = %q, want %q", got, tt.wantStatus)
+			}
+		})
+	}
+}
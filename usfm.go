@@ -0,0 +1,311 @@
+package main
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// usfmBookCodes maps USFM book identifiers onto biblicalOrder names.
+var usfmBookCodes = map[string]string{
+	"GEN": "Genesis", "EXO": "Exodus", "LEV": "Leviticus", "NUM": "Numbers",
+	"DEU": "Deuteronomy", "JOS": "Joshua", "JDG": "Judges", "RUT": "Ruth",
+	"1SA": "1 Samuel", "2SA": "2 Samuel", "1KI": "1 Kings", "2KI": "2 Kings",
+	"1CH": "1 Chronicles", "2CH": "2 Chronicles", "EZR": "Ezra", "NEH": "Nehemiah",
+	"EST": "Esther", "JOB": "Job", "PSA": "Psalm", "PRO": "Proverbs",
+	"ECC": "Ecclesiastes", "SNG": "Song Of Solomon", "ISA": "Isaiah", "JER": "Jeremiah",
+	"LAM": "Lamentations", "EZK": "Ezekiel", "DAN": "Daniel", "HOS": "Hosea",
+	"JOL": "Joel", "AMO": "Amos", "OBA": "Obadiah", "JON": "Jonah",
+	"MIC": "Micah", "NAM": "Nahum", "HAB": "Habakkuk", "ZEP": "Zephaniah",
+	"HAG": "Haggai", "ZEC": "Zechariah", "MAL": "Malachi",
+	"MAT": "Matthew", "MRK": "Mark", "LUK": "Luke", "JHN": "John",
+	"ACT": "Acts", "ROM": "Romans", "1CO": "1 Corinthians", "2CO": "2 Corinthians",
+	"GAL": "Galatians", "EPH": "Ephesians", "PHP": "Philippians", "COL": "Colossians",
+	"1TH": "1 Thessalonians", "2TH": "2 Thessalonians", "1TI": "1 Timothy", "2TI": "2 Timothy",
+	"TIT": "Titus", "PHM": "Philemon", "HEB": "Hebrews", "JAS": "James",
+	"1PE": "1 Peter", "2PE": "2 Peter", "1JN": "1 John", "2JN": "2 John",
+	"3JN": "3 John", "JUD": "Jude", "REV": "Revelation",
+}
+
+var (
+	// Markers whose text runs to the end of the line and is not part of
+	// any verse: identification, headings, titles and introductions.
+	usfmLineMarkers = map[string]bool{
+		"id": true, "ide": true, "h": true, "toc": true, "toca": true, "rem": true,
+		"sts": true, "usfm": true, "mt": true, "mte": true, "ms": true, "mr": true,
+		"s": true, "sr": true, "r": true, "d": true, "sp": true, "cl": true, "cd": true,
+		"imt": true, "imte": true, "is": true, "ip": true, "ipi": true, "im": true,
+		"imi": true, "ipq": true, "imq": true, "ipr": true, "iq": true, "ib": true,
+		"ili": true, "iot": true, "io": true, "iex": true, "ie": true,
+	}
+
+	// Markers whose content up to the matching end marker is dropped:
+	// footnotes, cross references and alternate numbering.
+	usfmSkippedSpans = map[string]bool{
+		"f": true, "fe": true, "ef": true, "x": true, "ex": true,
+		"ca": true, "va": true, "vp": true, "fig": true, "cat": true,
+	}
+
+	// Character-level markers are removed but their text is kept in place.
+	usfmCharacterMarkers = map[string]bool{
+		"add": true, "bk": true, "dc": true, "k": true, "nd": true, "ord": true,
+		"pn": true, "png": true, "addpn": true, "qt": true, "sig": true, "sls": true,
+		"tl": true, "wj": true, "em": true, "bd": true, "it": true, "bdit": true,
+		"no": true, "sc": true, "sup": true, "w": true, "wg": true, "wh": true,
+		"wa": true, "rb": true, "pro": true, "rq": true, "qs": true, "qac": true,
+		"lik": true, "liv": true, "jmp": true, "ndx": true, "fw": true,
+	}
+)
+
+// parseUSFMBook adds the verses of a single USFM book to bible. Notes,
+// headings and introductions are stripped; paragraph and poetry markers
+// become plain spaces and character formatting is removed. Files whose
+// \id is not a known book code (front matter, glossaries) are ignored.
+func parseUSFMBook(bible Bible, source string) {
+	var (
+		book    string
+		chapter int
+		verse   int
+		text    strings.Builder
+	)
+
+	flush := func() {
+		if book != "" && chapter > 0 && verse > 0 {
+			chapterKey := strconv.Itoa(chapter)
+			if bible[book][chapterKey] == nil {
+				bible[book][chapterKey] = make(map[string]string)
+			}
+			bible[book][chapterKey][strconv.Itoa(verse)] = strings.Join(strings.Fields(text.String()), " ")
+		}
+		verse = 0
+		text.Reset()
+	}
+
+	for i := 0; i < len(source); {
+		if source[i] != '\\' {
+			next := strings.IndexByte(source[i:], '\\')
+			if next < 0 {
+				next = len(source) - i
+			}
+			chunk := source[i : i+next]
+			// USFM 3 attributes (\w word|strong="H123"\w*) are not text.
+			if bar := strings.IndexByte(chunk, '|'); bar >= 0 {
+				chunk = chunk[:bar]
+			}
+			if verse > 0 {
+				text.WriteString(chunk)
+			}
+			i += next
+			continue
+		}
+
+		marker, closing, end := readUSFMMarker(source, i)
+		i = end
+		base := strings.TrimRight(strings.TrimPrefix(marker, "+"), "0123456789")
+
+		switch {
+		case closing:
+			continue
+
+		case marker == "id":
+			flush()
+			code, _, _ := strings.Cut(strings.TrimSpace(restOfLine(source, i)), " ")
+			code = strings.ToUpper(code)
+			if name, ok := usfmBookCodes[code]; ok {
+				book = name
+			} else {
+				book = ""
+			}
+			if book != "" && bible[book] == nil {
+				bible[book] = make(map[string]map[string]string)
+			}
+			i = skipLine(source, i)
+
+		case marker == "c":
+			flush()
+			number, next := readUSFMNumber(source, i)
+			chapter = number
+			i = next
+
+		case marker == "v":
+			flush()
+			number, next := readUSFMNumber(source, i)
+			verse = number
+			i = next
+
+		case usfmLineMarkers[base]:
+			i = skipLine(source, i)
+
+		case usfmSkippedSpans[base]:
+			closer := "\\" + marker + "*"
+			if idx := strings.Index(source[i:], closer); idx >= 0 {
+				i += idx + len(closer)
+			}
+
+		case usfmCharacterMarkers[base]:
+			// Formatting only; the enclosed text is kept.
+
+		default:
+			// Paragraph, poetry and list markers separate words.
+			if verse > 0 {
+				text.WriteByte(' ')
+			}
+		}
+	}
+	flush()
+}
+
+// readUSFMMarker reads the marker starting at the backslash at pos and
+// returns its name, whether it is a closing marker and the position after
+// it, including the single space that terminates an opening marker.
+func readUSFMMarker(source string, pos int) (string, bool, int) {
+	i := pos + 1
+	for i < len(source) {
+		c := source[i]
+		if c == '+' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' {
+			i++
+			continue
+		}
+		break
+	}
+	marker := source[pos+1 : i]
+
+	if i < len(source) && source[i] == '*' {
+		return marker, true, i + 1
+	}
+	if i < len(source) && (source[i] == ' ' || source[i] == '\n' || source[i] == '\r' || source[i] == '\t') {
+		i++
+	}
+	return marker, false, i
+}
+
+// readUSFMNumber reads a chapter or verse number such as "16", "16a" or
+// "16-17"; bridged and segmented verses are filed under the first number.
+func readUSFMNumber(source string, pos int) (int, int) {
+	for pos < len(source) && (source[pos] == ' ' || source[pos] == '\t') {
+		pos++
+	}
+	start := pos
+	for pos < len(source) && source[pos] >= '0' && source[pos] <= '9' {
+		pos++
+	}
+	number, _ := strconv.Atoi(source[start:pos])
+	for pos < len(source) && source[pos] != ' ' && source[pos] != '\n' && source[pos] != '\r' && source[pos] != '\\' {
+		pos++
+	}
+	return number, pos
+}
+
+func restOfLine(source string, pos int) string {
+	return source[pos:skipLine(source, pos)]
+}
+
+func skipLine(source string, pos int) int {
+	if idx := strings.IndexByte(source[pos:], '\n'); idx >= 0 {
+		return pos + idx + 1
+	}
+	return len(source)
+}
+
+func isUSFMFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".usfm", ".sfm":
+		return true
+	}
+	return false
+}
+
+// NewBibleDataFromUSFM builds a translation from the contents of its
+// USFM book files.
+func NewBibleDataFromUSFM(books [][]byte) (*BibleData, error) {
+	bible := make(Bible)
+	for _, data := range books {
+		parseUSFMBook(bible, string(data))
+	}
+
+	if len(bible) == 0 {
+		return nil, fmt.Errorf("failed to parse USFM: no books with a known \\id code found")
+	}
+
+	return newBibleDataFromBible(bible), nil
+}
+
+func loadUSFMDir(dir string) (*BibleData, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, &ReadError{Path: dir, Err: err}
+	}
+
+	var books [][]byte
+	for _, entry := range entries {
+		if entry.IsDir() || !isUSFMFile(entry.Name()) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, &ReadError{Path: filepath.Join(dir, entry.Name()), Err: err}
+		}
+		books = append(books, data)
+	}
+
+	return NewBibleDataFromUSFM(books)
+}
+
+func loadUSFMZip(path string) (*BibleData, error) {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, &ReadError{Path: path, Err: err}
+	}
+	defer archive.Close()
+
+	var books [][]byte
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() || !isUSFMFile(file.Name) {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, data)
+	}
+
+	return NewBibleDataFromUSFM(books)
+}
+
+func dirHasUSFM(dir string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() && isUSFMFile(entry.Name()) {
+			return true
+		}
+	}
+	return false
+}
+
+func zipHasUSFM(path string) bool {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		return false
+	}
+	defer archive.Close()
+
+	for _, file := range archive.File {
+		if isUSFMFile(file.Name) {
+			return true
+		}
+	}
+	return false
+}
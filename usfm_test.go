@@ -0,0 +1,125 @@
+package main
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestNewBibleDataFromUSFM(t *testing.T) {
+	tests := []struct {
+		name  string
+		books []string
+		want  []Verse
+	}{
+		{
+			name: "headings and paragraphs",
+			books: []string{`\id GEN Genesis
+\h Genesis
+\mt1 Genesis
+\c 1
+\s1 The Creation
+\p
+\v 1 In the beginning
+\v 2 And the earth
+\q1 was without form`},
+			want: []Verse{
+				{Book: "Genesis", Chapter: 1, Verse: 1, Text: "In the beginning"},
+				{Book: "Genesis", Chapter: 1, Verse: 2, Text: "And the earth was without form"},
+			},
+		},
+		{
+			name: "notes dropped and character markers removed",
+			books: []string{`\id JHN
+\c 3
+\p \v 16 \wj For God so \+w loved|strong="G25"\+w* the world\wj*\f + \fr 3:16 \ft Or only begotten\f*, that he gave`},
+			want: []Verse{{Book: "John", Chapter: 3, Verse: 16, Text: "For God so loved the world, that he gave"}},
+		},
+		{
+			name:  "bridged verse filed under the first number",
+			books: []string{"\\id ROM\n\\c 16\n\\v 25-27 Now to him"},
+			want:  []Verse{{Book: "Romans", Chapter: 16, Verse: 25, Text: "Now to him"}},
+		},
+		{
+			name:  "unknown book codes ignored",
+			books: []string{"\\id FRT\n\\c 1\n\\v 1 Preface", "\\id jud\n\\c 1\n\\v 1 Jude, a servant"},
+			want:  []Verse{{Book: "Jude", Chapter: 1, Verse: 1, Text: "Jude, a servant"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var books [][]byte
+			for _, book := range tt.books {
+				books = append(books, []byte(book))
+			}
+			bd, err := NewBibleDataFromUSFM(books)
+			if err != nil {
+				t.Fatalf("NewBibleDataFromUSFM: %v", err)
+			}
+			if !reflect.DeepEqual(bd.verses, tt.want) {
+				t.Errorf("verses = %q, want %q", bd.verses, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewBibleDataFromUSFMNoBooks(t *testing.T) {
+	if _, err := NewBibleDataFromUSFM([][]byte{[]byte("\\id GLO\n\\p Glossary")}); err == nil {
+		t.Error("NewBibleDataFromUSFM succeeded without a known book")
+	}
+}
+
+func TestLoadUSFM(t *testing.T) {
+	books := map[string]string{
+		"01-GEN.usfm": "\\id GEN\n\\c 1\n\\v 1 In the beginning",
+		"43-JHN.SFM":  "\\id JHN\n\\c 1\n\\v 1 In the beginning was the Word",
+		"readme.txt":  "\\id EXO\n\\c 1\n\\v 1 Not a book file",
+	}
+	want := []Verse{
+		{Book: "Genesis", Chapter: 1, Verse: 1, Text: "In the beginning"},
+		{Book: "John", Chapter: 1, Verse: 1, Text: "In the beginning was the Word"},
+	}
+
+	dir := t.TempDir()
+	zipPath := filepath.Join(t.TempDir(), "books.zip")
+	zipFile, err := os.Create(zipPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	archive := zip.NewWriter(zipFile)
+	for name, content := range books {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		w, err := archive.Create("usfm/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(content))
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	zipFile.Close()
+
+	tests := []struct {
+		name string
+		load func() (*BibleData, error)
+	}{
+		{"directory", func() (*BibleData, error) { return loadUSFMDir(dir) }},
+		{"zip", func() (*BibleData, error) { return loadUSFMZip(zipPath) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bd, err := tt.load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(bd.verses, want) {
+				t.Errorf("verses = %q, want %q", bd.verses, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type issueSeverity int
+
+const (
+	severityWarning issueSeverity = iota
+	severityError
+)
+
+func (s issueSeverity) String() string {
+	if s == severityError {
+		return "error"
+	}
+	return "warning"
+}
+
+type validationIssue struct {
+	severity issueSeverity
+	message  string
+}
+
+// Exit codes of the validate subcommand.
+const (
+	validateOK       = 0
+	validateProblems = 1
+	validateUsage    = 2
+)
+
+// runValidate implements "bible-go validate [-strict] [file...]". Without
+// files it checks every translation in the translations directory. It
+// exits with 1 if any file has errors (or warnings with -strict) and 2 on
+// usage errors.
+func runValidate(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("validate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	strict := flags.Bool("strict", false, "treat warnings as errors")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: bible-go validate [-strict] [file...]\n\n")
+		fmt.Fprintf(stderr, "Checks translation files and reports problems. Without files, every\n")
+		fmt.Fprintf(stderr, "translation in the translations directory is checked.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return validateUsage
+	}
+
+	files := flags.Args()
+	if len(files) == 0 {
+		translationsDir, err := getTranslationsDir()
+		if err != nil {
+			fmt.Fprintf(stderr, "Error: failed to get config dir: %v\n", err)
+			return validateUsage
+		}
+		source := NewDirectorySource(translationsDir)
+		infos, err := source.List()
+		if err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return validateUsage
+		}
+		for _, info := range infos {
+			files = append(files, info.Location)
+		}
+		if len(files) == 0 {
+			fmt.Fprintf(stderr, "Error: no translation files found in %s\n", source.Dir)
+			return validateUsage
+		}
+	}
+
+	exitCode := validateOK
+	for _, file := range files {
+		issues := validateTranslationFile(file)
+		for _, issue := range issues {
+			fmt.Fprintf(stdout, "%s: %s: %s\n", file, issue.severity, issue.message)
+			if issue.severity == severityError || *strict {
+				exitCode = validateProblems
+			}
+		}
+		if len(issues) == 0 {
+			fmt.Fprintf(stdout, "%s: ok\n", file)
+		}
+	}
+
+	return exitCode
+}
+
+// validateTranslationFile loads a translation with the loader the app
+// uses and reports everything that would be dropped, misplaced or
+// suspicious.
+func validateTranslationFile(path string) []validationIssue {
+	bd, err := loadTranslationFile(path)
+	if err != nil {
+		return []validationIssue{{severityError, err.Error()}}
+	}
+
+	var issues []validationIssue
+	if detectTranslationFormat(path) == formatJSON {
+		issues = append(issues, validateVerseKeys(path)...)
+	}
+	issues = append(issues, validateBookNames(bd)...)
+	for _, book := range bd.bookList {
+		issues = append(issues, validateBookNumbering(bd, book)...)
+	}
+	return issues
+}
+
+// validateVerseKeys reports the verse keys of a JSON translation that
+// the loader accepted but silently dropped because they are not numbers.
+func validateVerseKeys(path string) []validationIssue {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return []validationIssue{{severityError, (&ReadError{Path: path, Err: err}).Error()}}
+	}
+	var bible Bible
+	if err := json.Unmarshal(data, &bible); err != nil {
+		return []validationIssue{{severityError, jsonSyntaxError(data, err).Error()}}
+	}
+
+	var issues []validationIssue
+	for _, book := range sortedKeys(bible) {
+		for _, chapter := range sortedKeys(bible[book]) {
+			for _, verse := range sortedKeys(bible[book][chapter]) {
+				if _, err := strconv.Atoi(verse); err != nil {
+					issues = append(issues, validationIssue{severityError,
+						fmt.Sprintf("%s %s: non-integer verse key %q is ignored", book, chapter, verse)})
+				}
+			}
+		}
+	}
+	return issues
+}
+
+func validateBookNames(bd *BibleData) []validationIssue {
+	var issues []validationIssue
+	variants := make(map[string][]string)
+
+	for _, book := range bd.bookList {
+		canonical := canonicalBook(book)
+		switch {
+		case canonical == "":
+			issues = append(issues, validationIssue{severityWarning,
+				fmt.Sprintf("unknown book name %q", book)})
+			continue
+		case canonical != book:
+			issues = append(issues, validationIssue{severityWarning,
+				fmt.Sprintf("book name %q is not the standard name %q", book, canonical)})
+		}
+		variants[canonical] = append(variants[canonical], book)
+	}
+
+	for _, canonical := range biblicalOrder {
+		if names := variants[canonical]; len(names) > 1 {
+			issues = append(issues, validationIssue{severityError,
+				fmt.Sprintf("%s appears more than once, as %q", canonical, names)})
+		}
+	}
+	return issues
+}
+
+// validateBookNumbering checks a book for gaps in its chapter and verse
+// numbers, empty verses and differences from the KJV versification.
+func validateBookNumbering(bd *BibleData, book string) []validationIssue {
+	var issues []validationIssue
+	reference := kjvVerseCounts[canonicalBook(book)]
+
+	chapters := make([]int, 0, len(bd.chapterIndex[book]))
+	for chapter := range bd.chapterIndex[book] {
+		chapters = append(chapters, chapter)
+	}
+	sort.Ints(chapters)
+
+	if gaps := numberingGaps(chapters); len(gaps) > 0 {
+		issues = append(issues, validationIssue{severityWarning,
+			fmt.Sprintf("%s: missing chapters %s", book, formatNumberRanges(gaps))})
+	}
+	if reference != nil && len(chapters) > 0 && chapters[len(chapters)-1] != len(reference) {
+		issues = append(issues, validationIssue{severityWarning,
+			fmt.Sprintf("%s has %d chapters, the KJV versification has %d", book, chapters[len(chapters)-1], len(reference))})
+	}
+
+	for _, chapter := range chapters {
+		verses := bd.chapterIndex[book][chapter]
+		numbers := make([]int, len(verses))
+		for i, verse := range verses {
+			numbers[i] = verse.Verse
+			if verse.Text == "" {
+				issues = append(issues, validationIssue{severityWarning,
+					fmt.Sprintf("%s %d:%d has no text", book, chapter, verse.Verse)})
+			}
+		}
+
+		if gaps := numberingGaps(numbers); len(gaps) > 0 {
+			issues = append(issues, validationIssue{severityWarning,
+				fmt.Sprintf("%s %d: missing verses %s", book, chapter, formatNumberRanges(gaps))})
+		}
+		if reference != nil && chapter <= len(reference) && len(numbers) > 0 && numbers[len(numbers)-1] != reference[chapter-1] {
+			issues = append(issues, validationIssue{severityWarning,
+				fmt.Sprintf("%s %d has %d verses, the KJV versification has %d", book, chapter, numbers[len(numbers)-1], reference[chapter-1])})
+		}
+	}
+	return issues
+}
+
+// numberingGaps returns the numbers between 1 and the largest of the
+// sorted numbers that do not occur in it.
+func numberingGaps(numbers []int) []int {
+	var gaps []int
+	next := 1
+	for _, n := range numbers {
+		for ; next < n; next++ {
+			gaps = append(gaps, next)
+		}
+		next = max(next, n+1)
+	}
+	return gaps
+}
+
+// formatNumberRanges collapses sorted numbers into ranges: "1-3, 7".
+func formatNumberRanges(numbers []int) string {
+	var parts []string
+	for i := 0; i < len(numbers); {
+		j := i
+		for j+1 < len(numbers) && numbers[j+1] == numbers[j]+1 {
+			j++
+		}
+		if i == j {
+			parts = append(parts, strconv.Itoa(numbers[i]))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d-%d", numbers[i], numbers[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ", ")
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
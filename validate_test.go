@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// obadiahJSON returns Obadiah, which has a single chapter of 21 verses in
+// the KJV versification, without the given verses.
+func obadiahJSON(skip ...int) string {
+	var verses []string
+	for verse := 1; verse <= 21; verse++ {
+		if !slices.Contains(skip, verse) {
+			verses = append(verses, fmt.Sprintf(`"%d": "verse %d"`, verse, verse))
+		}
+	}
+	return `{"Obadiah": {"1": {` + strings.Join(verses, ", ") + `}}}`
+}
+
+func TestRunValidate(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"clean.json":     obadiahJSON(),
+		"gap.json":       obadiahJSON(5),
+		"verse-key.json": `{"Obadiah": {"1": {"1": "verse 1", "x": "stray"}}}`,
+		"empty.json":     `{"Obadiah": {}}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		want       int
+		wantOutput string
+	}{
+		{name: "clean file", args: []string{"clean.json"}, want: validateOK, wantOutput: "clean.json: ok"},
+		{name: "warnings", args: []string{"gap.json"}, want: validateOK, wantOutput: "gap.json: warning: Obadiah 1: missing verses 5"},
+		{name: "warnings with -strict", args: []string{"-strict", "gap.json"}, want: validateProblems, wantOutput: "gap.json: warning: Obadiah 1: missing verses 5"},
+		{name: "clean file with -strict", args: []string{"-strict", "clean.json"}, want: validateOK, wantOutput: "clean.json: ok"},
+		{name: "ignored verse key", args: []string{"verse-key.json"}, want: validateProblems, wantOutput: `error: Obadiah 1: non-integer verse key "x" is ignored`},
+		{name: "book without verses", args: []string{"empty.json"}, want: validateProblems, wantOutput: `error: book "Obadiah" has no verses`},
+		{name: "unreadable file", args: []string{"missing.json"}, want: validateProblems, wantOutput: "missing.json: error: cannot read"},
+		{name: "one bad file of several", args: []string{"clean.json", "missing.json"}, want: validateProblems, wantOutput: "clean.json: ok"},
+		{name: "unknown flag", args: []string{"-bogus"}, want: validateUsage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := make([]string, len(tt.args))
+			for i, arg := range tt.args {
+				if strings.HasPrefix(arg, "-") {
+					args[i] = arg
+				} else {
+					args[i] = filepath.Join(dir, arg)
+				}
+			}
+			var stdout, stderr strings.Builder
+			if got := runValidate(args, &stdout, &stderr); got != tt.want {
+				t.Errorf("runValidate(%v) = %d, want %d\nstdout: %s\nstderr: %s", tt.args, got, tt.want, stdout.String(), stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tt.wantOutput) {
+				t.Errorf("output %q does not contain %q", stdout.String(), tt.wantOutput)
+			}
+		})
+	}
+}
@@ -0,0 +1,555 @@
+package main
+
+import "fmt"
+
+// A versification is a verse numbering tradition. Every scheme is
+// described by how it differs from the KJV numbering, so any two schemes
+// can be mapped onto each other through KJV.
+type versification struct {
+	name   string
+	shifts []versificationShift
+}
+
+// versificationShift moves a span of KJV verses to another chapter and
+// verse number: KJV book fromChapter:fromStart-fromEnd becomes
+// toChapter:(verse+offset). A zero fromEnd means "to the end of the
+// chapter".
+type versificationShift struct {
+	book        string
+	fromChapter int
+	fromStart   int
+	fromEnd     int
+	toChapter   int
+	offset      int
+}
+
+func (s versificationShift) coversKJV(chapter, verse int) bool {
+	return chapter == s.fromChapter && verse >= s.fromStart && (s.fromEnd == 0 || verse <= s.fromEnd)
+}
+
+func (s versificationShift) coversScheme(chapter, verse int) bool {
+	return chapter == s.toChapter && s.coversKJV(s.fromChapter, verse-s.offset)
+}
+
+// fromKJV converts a KJV chapter and verse of the canonical book into
+// this scheme.
+func (v *versification) fromKJV(book string, chapter, verse int) (int, int) {
+	for _, shift := range v.shifts {
+		if shift.book == book && shift.coversKJV(chapter, verse) {
+			return shift.toChapter, verse + shift.offset
+		}
+	}
+	return chapter, verse
+}
+
+// toKJV converts a chapter and verse of this scheme into KJV numbering.
+// Verses that only exist in this scheme, such as psalm titles numbered
+// as verses, map to the first verse that follows them.
+func (v *versification) toKJV(book string, chapter, verse int) (int, int) {
+	for _, shift := range v.shifts {
+		if shift.book == book && shift.coversScheme(chapter, verse) {
+			return shift.fromChapter, verse - shift.offset
+		}
+	}
+	if title, ok := v.titleShift(book, chapter, verse); ok {
+		return title.fromChapter, 1
+	}
+	return chapter, verse
+}
+
+// toKJVKey is toKJV for telling verses apart rather than navigating:
+// verses that only exist in this scheme are numbered back from 0 before
+// the verse they precede instead of sharing its number, so a two-verse
+// psalm title becomes verses -1 and 0.
+func (v *versification) toKJVKey(book string, chapter, verse int) (int, int) {
+	if title, ok := v.titleShift(book, chapter, verse); ok {
+		return title.fromChapter, verse - title.offset
+	}
+	return v.toKJV(book, chapter, verse)
+}
+
+// titleShift reports whether chapter:verse only exists in this scheme and
+// returns the shift of the passage it precedes. Such a verse takes the
+// number of a KJV verse that was moved away, and no shift moves a verse
+// onto it; of the passages starting after it, the nearest is the one it
+// belongs to.
+func (v *versification) titleShift(book string, chapter, verse int) (versificationShift, bool) {
+	moved := false
+	for _, shift := range v.shifts {
+		if shift.book != book {
+			continue
+		}
+		if shift.coversScheme(chapter, verse) {
+			return versificationShift{}, false
+		}
+		moved = moved || shift.coversKJV(chapter, verse)
+	}
+	if !moved {
+		return versificationShift{}, false
+	}
+
+	var title versificationShift
+	found := false
+	for _, shift := range v.shifts {
+		if shift.book == book && shift.toChapter == chapter && shift.fromStart == 1 && verse <= shift.offset &&
+			(!found || shift.offset < title.offset) {
+			title, found = shift, true
+		}
+	}
+	return title, found
+}
+
+// mapVerseRef maps a reference numbered in the from scheme onto the to
+// scheme. The book name is kept as given.
+func mapVerseRef(ref verseKey, from, to *versification) verseKey {
+	if from == to {
+		return ref
+	}
+	book := canonicalBook(ref.book)
+	chapter, verse := from.toKJV(book, ref.chapter, ref.verse)
+	chapter, verse = to.fromKJV(book, chapter, verse)
+	return verseKey{book: ref.book, chapter: chapter, verse: verse}
+}
+
+var (
+	kjvVersification = &versification{name: "kjv"}
+
+	// Hebrew Masoretic numbering, as used by BHS and most Jewish
+	// translations: psalm titles are numbered as verses and a number of
+	// chapter boundaries fall elsewhere.
+	mtVersification = &versification{name: "mt", shifts: append(mtChapterShifts(), psalmTitleShifts(identityPsalmChapter)...)}
+
+	// Septuagint numbering, as used by Orthodox translations: Psalms 9-10
+	// and 114-115 are joined and 116 and 147 split, titles are numbered
+	// as verses, and the Romans doxology follows 14:23.
+	lxxVersification = &versification{name: "lxx", shifts: append(greekPsalmShifts(), versificationShift{"Romans", 16, 25, 27, 14, -1})}
+
+	// Vulgate numbering: the Greek psalm numbering on an otherwise
+	// English-style text.
+	vulgateVersification = &versification{name: "vulgate", shifts: greekPsalmShifts()}
+
+	versifications = map[string]*versification{
+		"kjv":     kjvVersification,
+		"mt":      mtVersification,
+		"lxx":     lxxVersification,
+		"vulgate": vulgateVersification,
+	}
+)
+
+func lookupVersification(name string) (*versification, error) {
+	if v, ok := versifications[name]; ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("unknown versification %q (expected kjv, mt, lxx or vulgate)", name)
+}
+
+// mtChapterShifts lists the places outside Psalms where the Hebrew
+// chapter divisions differ from the English ones.
+func mtChapterShifts() []versificationShift {
+	return []versificationShift{
+		{"Genesis", 31, 55, 55, 32, -54},
+		{"Genesis", 32, 1, 0, 32, 1},
+		{"Exodus", 8, 1, 4, 7, 25},
+		{"Exodus", 8, 5, 0, 8, -4},
+		{"Exodus", 22, 1, 1, 21, 36},
+		{"Exodus", 22, 2, 0, 22, -1},
+		{"Leviticus", 6, 1, 7, 5, 19},
+		{"Leviticus", 6, 8, 0, 6, -7},
+		{"Numbers", 16, 36, 50, 17, -35},
+		{"Numbers", 17, 1, 0, 17, 15},
+		{"Numbers", 29, 40, 40, 30, -39},
+		{"Numbers", 30, 1, 0, 30, 1},
+		{"Deuteronomy", 12, 32, 32, 13, -31},
+		{"Deuteronomy", 13, 1, 0, 13, 1},
+		{"Deuteronomy", 22, 30, 30, 23, -29},
+		{"Deuteronomy", 23, 1, 0, 23, 1},
+		{"Deuteronomy", 29, 1, 1, 28, 68},
+		{"Deuteronomy", 29, 2, 0, 29, -1},
+		{"1 Samuel", 23, 29, 29, 24, -28},
+		{"1 Samuel", 24, 1, 0, 24, 1},
+		{"2 Samuel", 18, 33, 33, 19, -32},
+		{"2 Samuel", 19, 1, 0, 19, 1},
+		{"1 Kings", 4, 21, 34, 5, -20},
+		{"1 Kings", 5, 1, 0, 5, 14},
+		{"2 Kings", 11, 21, 21, 12, -20},
+		{"2 Kings", 12, 1, 0, 12, 1},
+		{"1 Chronicles", 6, 1, 15, 5, 26},
+		{"1 Chronicles", 6, 16, 0, 6, -15},
+		{"2 Chronicles", 2, 1, 1, 1, 17},
+		{"2 Chronicles", 2, 2, 0, 2, -1},
+		{"2 Chronicles", 14, 1, 1, 13, 22},
+		{"2 Chronicles", 14, 2, 0, 14, -1},
+		{"Nehemiah", 4, 1, 6, 3, 32},
+		{"Nehemiah", 4, 7, 0, 4, -6},
+		{"Nehemiah", 9, 38, 38, 10, -37},
+		{"Nehemiah", 10, 1, 0, 10, 1},
+		{"Job", 41, 1, 8, 40, 24},
+		{"Job", 41, 9, 0, 41, -8},
+		{"Ecclesiastes", 5, 1, 1, 4, 16},
+		{"Ecclesiastes", 5, 2, 0, 5, -1},
+		{"Song Of Solomon", 6, 13, 13, 7, -12},
+		{"Song Of Solomon", 7, 1, 0, 7, 1},
+		{"Isaiah", 9, 1, 1, 8, 22},
+		{"Isaiah", 9, 2, 0, 9, -1},
+		{"Isaiah", 64, 1, 1, 63, 18},
+		{"Isaiah", 64, 2, 0, 64, -1},
+		{"Jeremiah", 9, 1, 1, 8, 22},
+		{"Jeremiah", 9, 2, 0, 9, -1},
+		{"Ezekiel", 20, 45, 49, 21, -44},
+		{"Ezekiel", 21, 1, 0, 21, 5},
+		{"Daniel", 4, 1, 3, 3, 30},
+		{"Daniel", 4, 4, 0, 4, -3},
+		{"Daniel", 5, 31, 31, 6, -30},
+		{"Daniel", 6, 1, 0, 6, 1},
+		{"Hosea", 1, 10, 11, 2, -9},
+		{"Hosea", 2, 1, 0, 2, 2},
+		{"Hosea", 11, 12, 12, 12, -11},
+		{"Hosea", 12, 1, 0, 12, 1},
+		{"Hosea", 13, 16, 16, 14, -15},
+		{"Hosea", 14, 1, 0, 14, 1},
+		{"Joel", 2, 28, 32, 3, -27},
+		{"Joel", 3, 1, 0, 4, 0},
+		{"Jonah", 1, 17, 17, 2, -16},
+		{"Jonah", 2, 1, 0, 2, 1},
+		{"Micah", 5, 1, 1, 4, 13},
+		{"Micah", 5, 2, 0, 5, -1},
+		{"Nahum", 1, 15, 15, 2, -14},
+		{"Nahum", 2, 1, 0, 2, 1},
+		{"Zechariah", 1, 18, 21, 2, -17},
+		{"Zechariah", 2, 1, 0, 2, 4},
+		{"Malachi", 4, 1, 0, 3, 18},
+	}
+}
+
+// psalmTitleVerses is the number of verses the Hebrew numbering gives to
+// a psalm's title, for the psalms where the title is numbered.
+var psalmTitleVerses = func() map[int]int {
+	titles := map[int]int{51: 2, 52: 2, 54: 2, 60: 2}
+	for _, psalm := range []int{
+		3, 4, 5, 6, 7, 8, 9, 12, 13, 18, 19, 20, 21, 22, 30, 31, 34, 36, 38, 39,
+		40, 41, 42, 44, 45, 46, 47, 48, 49, 53, 55, 56, 57, 58, 59, 61, 62, 63,
+		64, 65, 67, 68, 69, 70, 75, 76, 77, 80, 81, 83, 84, 85, 88, 89, 92, 102,
+		108, 140, 142,
+	} {
+		titles[psalm] = 1
+	}
+	return titles
+}()
+
+func identityPsalmChapter(psalm int) int { return psalm }
+
+// psalmTitleShifts shifts the verses of every psalm with a numbered
+// title, placing the psalm at chapter(psalm).
+func psalmTitleShifts(chapter func(int) int) []versificationShift {
+	var shifts []versificationShift
+	for psalm := 1; psalm <= 150; psalm++ {
+		if titles := psalmTitleVerses[psalm]; titles > 0 || chapter(psalm) != psalm {
+			shifts = append(shifts, versificationShift{"Psalm", psalm, 1, 0, chapter(psalm), titles})
+		}
+	}
+	return shifts
+}
+
+// greekPsalmShifts describes the Septuagint and Vulgate psalm numbering:
+// Hebrew numbering of titles, with psalms renumbered where the Greek
+// joins or splits them.
+func greekPsalmShifts() []versificationShift {
+	shifts := []versificationShift{
+		{"Psalm", 10, 1, 0, 9, 21},
+		{"Psalm", 115, 1, 0, 113, 8},
+		{"Psalm", 116, 1, 9, 114, 0},
+		{"Psalm", 116, 10, 0, 115, -9},
+		{"Psalm", 147, 1, 11, 146, 0},
+		{"Psalm", 147, 12, 0, 147, -11},
+	}
+	return append(shifts, psalmTitleShifts(func(psalm int) int {
+		switch {
+		case psalm >= 11 && psalm <= 114, psalm >= 117 && psalm <= 146:
+			return psalm - 1
+		default:
+			return psalm
+		}
+	})...)
+}
+
+// Versification returns the numbering used by translation: the scheme
+// configured for it, or else the one detected from its text. Translations
+// that are not loaded yet are assumed to follow KJV numbering.
+func (mbd *MultiBibleData) Versification(translation string) *versification {
+	mbd.mu.Lock()
+	defer mbd.mu.Unlock()
+	if v, exists := mbd.versifications[translation]; exists {
+		return v
+	}
+	bd, exists := mbd.translations[translation]
+	if !exists {
+		return kjvVersification
+	}
+	v := bd.detectVersification()
+	mbd.versifications[translation] = v
+	return v
+}
+
+// SetVersifications overrides the detected numbering of translations,
+// mapping translation names to kjv, mt, lxx or vulgate.
+func (mbd *MultiBibleData) SetVersifications(schemes map[string]string) error {
+	mbd.mu.Lock()
+	defer mbd.mu.Unlock()
+	for translation, name := range schemes {
+		v, err := lookupVersification(name)
+		if err != nil {
+			return fmt.Errorf("translation %s: %w", translation, err)
+		}
+		mbd.versifications[translation] = v
+	}
+	return nil
+}
+
+// MapVerse converts a reference in the numbering of translation from to
+// the numbering of translation to, so both point at the same passage.
+func (mbd *MultiBibleData) MapVerse(ref verseKey, from, to string) verseKey {
+	return mapVerseRef(ref, mbd.Versification(from), mbd.Versification(to))
+}
+
+// translationBook returns the name this translation uses for book, which
+// may be spelled differently in another translation, or "" if the
+// translation does not contain it.
+func (bd *BibleData) translationBook(book string) string {
+	if _, exists := bd.chapterIndex[book]; exists {
+		return book
+	}
+	if canonical := canonicalBook(book); canonical != "" {
+		return bd.bookByCanonicalName(canonical)
+	}
+	return ""
+}
+
+// lookupVerse returns the verse at ref, if the translation has it.
+func (bd *BibleData) lookupVerse(ref verseKey) (Verse, bool) {
+	for _, verse := range bd.GetVerses(ref.book, ref.chapter) {
+		if verse.Verse == ref.verse {
+			return verse, true
+		}
+	}
+	return Verse{}, false
+}
+
+// nearestVerse returns the verse of ref.book closest to ref: the verse
+// itself, the last verse before it in the same chapter, or the nearest
+// end of the nearest chapter. ok is false if the book has no verses.
+func (bd *BibleData) nearestVerse(ref verseKey) (verse Verse, ok bool) {
+	chapter, distance := 0, 0
+	for c := range bd.chapterIndex[ref.book] {
+		d := abs(c - ref.chapter)
+		if chapter == 0 || d < distance || (d == distance && c < chapter) {
+			chapter, distance = c, d
+		}
+	}
+	verses := bd.GetVerses(ref.book, chapter)
+	if len(verses) == 0 {
+		return Verse{}, false
+	}
+
+	switch {
+	case chapter < ref.chapter:
+		return verses[len(verses)-1], true
+	case chapter > ref.chapter:
+		return verses[0], true
+	}
+	verse = verses[0]
+	for _, v := range verses {
+		if v.Verse <= ref.verse {
+			verse = v
+		}
+	}
+	return verse, true
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// detectVersification guesses the numbering of a translation from a few
+// telling chapters: a three-chapter Malachi means Hebrew numbering, a
+// psalm 9 running into psalm 10 means Greek numbering, and a Romans 14
+// holding the doxology means the Septuagint tradition.
+func (bd *BibleData) detectVersification() *versification {
+	versesIn := func(canonical string, chapter int) int {
+		if book := bd.bookByCanonicalName(canonical); book != "" {
+			return len(bd.GetVerses(book, chapter))
+		}
+		return 0
+	}
+
+	switch {
+	case versesIn("Psalm", 9) > 30:
+		if versesIn("Romans", 14) > 23 {
+			return lxxVersification
+		}
+		return vulgateVersification
+	case versesIn("Malachi", 3) > 18 && versesIn("Malachi", 4) == 0,
+		versesIn("Psalm", 3) == 9:
+		return mtVersification
+	default:
+		return kjvVersification
+	}
+}
+
+// kjvVerseCounts holds the number of verses in each chapter of every book
+// in the KJV versification, used as the reference when validating
+// translation files.
+var kjvVerseCounts = map[string][]int{
+	"Genesis": {
+		31, 25, 24, 26, 32, 22, 24, 22, 29, 32, 32, 20, 18, 24, 21, 16, 27, 33, 38, 18,
+		34, 24, 20, 67, 34, 35, 46, 22, 35, 43, 55, 32, 20, 31, 29, 43, 36, 30, 23, 23,
+		57, 38, 34, 34, 28, 34, 31, 22, 33, 26,
+	},
+	"Exodus": {
+		22, 25, 22, 31, 23, 30, 25, 32, 35, 29, 10, 51, 22, 31, 27, 36, 16, 27, 25, 26,
+		36, 31, 33, 18, 40, 37, 21, 43, 46, 38, 18, 35, 23, 35, 35, 38, 29, 31, 43, 38,
+	},
+	"Leviticus": {
+		17, 16, 17, 35, 19, 30, 38, 36, 24, 20, 47, 8, 59, 57, 33, 34, 16, 30, 37, 27,
+		24, 33, 44, 23, 55, 46, 34,
+	},
+	"Numbers": {
+		54, 34, 51, 49, 31, 27, 89, 26, 23, 36, 35, 16, 33, 45, 41, 50, 13, 32, 22, 29,
+		35, 41, 30, 25, 18, 65, 23, 31, 40, 16, 54, 42, 56, 29, 34, 13,
+	},
+	"Deuteronomy": {
+		46, 37, 29, 49, 33, 25, 26, 20, 29, 22, 32, 32, 18, 29, 23, 22, 20, 22, 21, 20,
+		23, 30, 25, 22, 19, 19, 26, 68, 29, 20, 30, 52, 29, 12,
+	},
+	"Joshua": {
+		18, 24, 17, 24, 15, 27, 26, 35, 27, 43, 23, 24, 33, 15, 63, 10, 18, 28, 51, 9,
+		45, 34, 16, 33,
+	},
+	"Judges": {
+		36, 23, 31, 24, 31, 40, 25, 35, 57, 18, 40, 15, 25, 20, 20, 31, 13, 31, 30, 48,
+		25,
+	},
+	"Ruth": {22, 23, 18, 22},
+	"1 Samuel": {
+		28, 36, 21, 22, 12, 21, 17, 22, 27, 27, 15, 25, 23, 52, 35, 23, 58, 30, 24, 42,
+		15, 23, 29, 22, 44, 25, 12, 25, 11, 31, 13,
+	},
+	"2 Samuel": {
+		27, 32, 39, 12, 25, 23, 29, 18, 13, 19, 27, 31, 39, 33, 37, 23, 29, 33, 43, 26,
+		22, 51, 39, 25,
+	},
+	"1 Kings": {
+		53, 46, 28, 34, 18, 38, 51, 66, 28, 29, 43, 33, 34, 31, 34, 34, 24, 46, 21, 43,
+		29, 53,
+	},
+	"2 Kings": {
+		18, 25, 27, 44, 27, 33, 20, 29, 37, 36, 21, 21, 25, 29, 38, 20, 41, 37, 37, 21,
+		26, 20, 37, 20, 30,
+	},
+	"1 Chronicles": {
+		54, 55, 24, 43, 26, 81, 40, 40, 44, 14, 47, 40, 14, 17, 29, 43, 27, 17, 19, 8,
+		30, 19, 32, 31, 31, 32, 34, 21, 30,
+	},
+	"2 Chronicles": {
+		17, 18, 17, 22, 14, 42, 22, 18, 31, 19, 23, 16, 22, 15, 19, 14, 19, 34, 11, 37,
+		20, 12, 21, 27, 28, 23, 9, 27, 36, 27, 21, 33, 25, 33, 27, 23,
+	},
+	"Ezra":     {11, 70, 13, 24, 17, 22, 28, 36, 15, 44},
+	"Nehemiah": {11, 20, 32, 23, 19, 19, 73, 18, 38, 39, 36, 47, 31},
+	"Esther":   {22, 23, 15, 17, 14, 14, 10, 17, 32, 3},
+	"Job": {
+		22, 13, 26, 21, 27, 30, 21, 22, 35, 22, 20, 25, 28, 22, 35, 22, 16, 21, 29, 29,
+		34, 30, 17, 25, 6, 14, 23, 28, 25, 31, 40, 22, 33, 37, 16, 33, 24, 41, 30, 24,
+		34, 17,
+	},
+	"Psalm": {
+		6, 12, 8, 8, 12, 10, 17, 9, 20, 18, 7, 8, 6, 7, 5, 11, 15, 50, 14, 9,
+		13, 31, 6, 10, 22, 12, 14, 9, 11, 12, 24, 11, 22, 22, 28, 12, 40, 22, 13, 17,
+		13, 11, 5, 26, 17, 11, 9, 14, 20, 23, 19, 9, 6, 7, 23, 13, 11, 11, 17, 12,
+		8, 12, 11, 10, 13, 20, 7, 35, 36, 5, 24, 20, 28, 23, 10, 12, 20, 72, 13, 19,
+		16, 8, 18, 12, 13, 17, 7, 18, 52, 17, 16, 15, 5, 23, 11, 13, 12, 9, 9, 5,
+		8, 28, 22, 35, 45, 48, 43, 13, 31, 7, 10, 10, 9, 8, 18, 19, 2, 29, 176, 7,
+		8, 9, 4, 8, 5, 6, 5, 6, 8, 8, 3, 18, 3, 3, 21, 26, 9, 8, 24, 13,
+		10, 7, 12, 15, 21, 10, 20, 14, 9, 6,
+	},
+	"Proverbs": {
+		33, 22, 35, 27, 23, 35, 27, 36, 18, 32, 31, 28, 25, 35, 33, 33, 28, 24, 29, 30,
+		31, 29, 35, 34, 28, 28, 27, 28, 27, 33, 31,
+	},
+	"Ecclesiastes":    {18, 26, 22, 16, 20, 12, 29, 17, 18, 20, 10, 14},
+	"Song Of Solomon": {17, 17, 11, 16, 16, 13, 13, 14},
+	"Isaiah": {
+		31, 22, 26, 6, 30, 13, 25, 22, 21, 34, 16, 6, 22, 32, 9, 14, 14, 7, 25, 6,
+		17, 25, 18, 23, 12, 21, 13, 29, 24, 33, 9, 20, 24, 17, 10, 22, 38, 22, 8, 31,
+		29, 25, 28, 28, 25, 13, 15, 22, 26, 11, 23, 15, 12, 17, 13, 12, 21, 14, 21, 22,
+		11, 12, 19, 12, 25, 24,
+	},
+	"Jeremiah": {
+		19, 37, 25, 31, 31, 30, 34, 22, 26, 25, 23, 17, 27, 22, 21, 21, 27, 23, 15, 18,
+		14, 30, 40, 10, 38, 24, 22, 17, 32, 24, 40, 44, 26, 22, 19, 32, 21, 28, 18, 16,
+		18, 22, 13, 30, 5, 28, 7, 47, 39, 46, 64, 34,
+	},
+	"Lamentations": {22, 22, 66, 22, 22},
+	"Ezekiel": {
+		28, 10, 27, 17, 17, 14, 27, 18, 11, 22, 25, 28, 23, 23, 8, 63, 24, 32, 14, 49,
+		32, 31, 49, 27, 17, 21, 36, 26, 21, 26, 18, 32, 33, 31, 15, 38, 28, 23, 29, 49,
+		26, 20, 27, 31, 25, 24, 23, 35,
+	},
+	"Daniel":    {21, 49, 30, 37, 31, 28, 28, 27, 27, 21, 45, 13},
+	"Hosea":     {11, 23, 5, 19, 15, 11, 16, 14, 17, 15, 12, 14, 16, 9},
+	"Joel":      {20, 32, 21},
+	"Amos":      {15, 16, 15, 13, 27, 14, 17, 14, 15},
+	"Obadiah":   {21},
+	"Jonah":     {17, 10, 10, 11},
+	"Micah":     {16, 13, 12, 13, 15, 16, 20},
+	"Nahum":     {15, 13, 19},
+	"Habakkuk":  {17, 20, 19},
+	"Zephaniah": {18, 15, 20},
+	"Haggai":    {15, 23},
+	"Zechariah": {21, 13, 10, 14, 11, 15, 14, 23, 17, 12, 17, 14, 9, 21},
+	"Malachi":   {14, 17, 18, 6},
+	"Matthew": {
+		25, 23, 17, 25, 48, 34, 29, 34, 38, 42, 30, 50, 58, 36, 39, 28, 27, 35, 30, 34,
+		46, 46, 39, 51, 46, 75, 66, 20,
+	},
+	"Mark": {45, 28, 35, 41, 43, 56, 37, 38, 50, 52, 33, 44, 37, 72, 47, 20},
+	"Luke": {
+		80, 52, 38, 44, 39, 49, 50, 56, 62, 42, 54, 59, 35, 35, 32, 31, 37, 43, 48, 47,
+		38, 71, 56, 53,
+	},
+	"John": {
+		51, 25, 36, 54, 47, 71, 53, 59, 41, 42, 57, 50, 38, 31, 27, 33, 26, 40, 42, 31,
+		25,
+	},
+	"Acts": {
+		26, 47, 26, 37, 42, 15, 60, 40, 43, 48, 30, 25, 52, 28, 41, 40, 34, 28, 41, 38,
+		40, 30, 35, 27, 27, 32, 44, 31,
+	},
+	"Romans":          {32, 29, 31, 25, 21, 23, 25, 39, 33, 21, 36, 21, 14, 23, 33, 27},
+	"1 Corinthians":   {31, 16, 23, 21, 13, 20, 40, 13, 27, 33, 34, 31, 13, 40, 58, 24},
+	"2 Corinthians":   {24, 17, 18, 18, 21, 18, 16, 24, 15, 18, 33, 21, 14},
+	"Galatians":       {24, 21, 29, 31, 26, 18},
+	"Ephesians":       {23, 22, 21, 32, 33, 24},
+	"Philippians":     {30, 30, 21, 23},
+	"Colossians":      {29, 23, 25, 18},
+	"1 Thessalonians": {10, 20, 13, 18, 28},
+	"2 Thessalonians": {12, 17, 18},
+	"1 Timothy":       {20, 15, 16, 16, 25, 21},
+	"2 Timothy":       {18, 26, 17, 22},
+	"Titus":           {16, 15, 15},
+	"Philemon":        {25},
+	"Hebrews":         {14, 18, 19, 16, 14, 20, 28, 13, 28, 39, 40, 29, 25},
+	"James":           {27, 26, 18, 17, 20},
+	"1 Peter":         {25, 25, 22, 19, 14},
+	"2 Peter":         {21, 22, 18},
+	"1 John":          {10, 29, 24, 21, 21},
+	"2 John":          {13},
+	"3 John":          {14},
+	"Jude":            {25},
+	"Revelation": {
+		20, 29, 22, 11, 14, 17, 17, 13, 21, 11, 19, 17, 18, 20, 8, 21, 18, 24, 21, 15,
+		27, 21,
+	},
+}
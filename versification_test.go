@@ -0,0 +1,157 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestMapVerseRef(t *testing.T) {
+	tests := []struct {
+		ref      verseKey
+		from, to *versification
+		want     verseKey
+	}{
+		{verseKey{"John", 3, 16}, kjvVersification, mtVersification, verseKey{"John", 3, 16}},
+		{verseKey{"Psalm", 23, 1}, kjvVersification, mtVersification, verseKey{"Psalm", 23, 1}},
+		{verseKey{"Psalm", 3, 1}, kjvVersification, mtVersification, verseKey{"Psalm", 3, 2}},
+		{verseKey{"Psalm", 51, 1}, kjvVersification, mtVersification, verseKey{"Psalm", 51, 3}},
+		{verseKey{"Psalm", 51, 3}, mtVersification, kjvVersification, verseKey{"Psalm", 51, 1}},
+		{verseKey{"Psalm", 51, 1}, mtVersification, kjvVersification, verseKey{"Psalm", 51, 1}},
+		{verseKey{"Psalms", 51, 1}, kjvVersification, lxxVersification, verseKey{"Psalms", 50, 3}},
+		{verseKey{"Psalm", 23, 1}, kjvVersification, vulgateVersification, verseKey{"Psalm", 22, 1}},
+		{verseKey{"Psalm", 10, 1}, kjvVersification, vulgateVersification, verseKey{"Psalm", 9, 22}},
+		{verseKey{"Psalm", 9, 22}, vulgateVersification, kjvVersification, verseKey{"Psalm", 10, 1}},
+		{verseKey{"Psalm", 116, 9}, kjvVersification, lxxVersification, verseKey{"Psalm", 114, 9}},
+		{verseKey{"Psalm", 116, 10}, kjvVersification, lxxVersification, verseKey{"Psalm", 115, 1}},
+		{verseKey{"Psalm", 147, 12}, kjvVersification, lxxVersification, verseKey{"Psalm", 147, 1}},
+		{verseKey{"Malachi", 4, 1}, kjvVersification, mtVersification, verseKey{"Malachi", 3, 19}},
+		{verseKey{"Malachi", 3, 24}, mtVersification, kjvVersification, verseKey{"Malachi", 4, 6}},
+		{verseKey{"Malachi", 3, 19}, mtVersification, lxxVersification, verseKey{"Malachi", 4, 1}},
+		{verseKey{"Malachi", 3, 5}, mtVersification, kjvVersification, verseKey{"Malachi", 3, 5}},
+		{verseKey{"Psalm", 9, 1}, lxxVersification, kjvVersification, verseKey{"Psalm", 9, 1}},
+		{verseKey{"Psalm", 10, 1}, lxxVersification, kjvVersification, verseKey{"Psalm", 11, 1}},
+		{verseKey{"Joel", 2, 28}, kjvVersification, mtVersification, verseKey{"Joel", 3, 1}},
+		{verseKey{"Joel", 3, 1}, kjvVersification, mtVersification, verseKey{"Joel", 4, 1}},
+		{verseKey{"Genesis", 31, 55}, kjvVersification, mtVersification, verseKey{"Genesis", 32, 1}},
+		{verseKey{"Genesis", 32, 1}, kjvVersification, mtVersification, verseKey{"Genesis", 32, 2}},
+		{verseKey{"Romans", 16, 25}, kjvVersification, lxxVersification, verseKey{"Romans", 14, 24}},
+		{verseKey{"Romans", 14, 26}, lxxVersification, kjvVersification, verseKey{"Romans", 16, 27}},
+		{verseKey{"Romans", 16, 25}, kjvVersification, vulgateVersification, verseKey{"Romans", 16, 25}},
+	}
+	for _, tt := range tests {
+		got := mapVerseRef(tt.ref, tt.from, tt.to)
+		if got != tt.want {
+			t.Errorf("mapVerseRef(%v, %s, %s) = %v, want %v", tt.ref, tt.from.name, tt.to.name, got, tt.want)
+		}
+	}
+}
+
+func TestLookupVersification(t *testing.T) {
+	for name, want := range versifications {
+		if got, err := lookupVersification(name); err != nil || got != want {
+			t.Errorf("lookupVersification(%q) = %v, %v, want %v", name, got, err, want)
+		}
+	}
+	if _, err := lookupVersification("nrsv"); err == nil {
+		t.Error("lookupVersification(\"nrsv\") succeeded")
+	}
+}
+
+// versificationTestBible builds a translation whose chapters have the
+// given numbers of verses.
+func versificationTestBible(chapters map[string]map[int]int) *BibleData {
+	bible := make(Bible)
+	for book, counts := range chapters {
+		bible[book] = make(map[string]map[string]string)
+		for chapter, count := range counts {
+			verses := make(map[string]string)
+			for verse := 1; verse <= count; verse++ {
+				verses[strconv.Itoa(verse)] = "text"
+			}
+			bible[book][strconv.Itoa(chapter)] = verses
+		}
+	}
+	return newBibleDataFromBible(bible)
+}
+
+func TestDetectVersification(t *testing.T) {
+	tests := []struct {
+		name     string
+		chapters map[string]map[int]int
+		want     *versification
+	}{
+		{"english", map[string]map[int]int{"Psalm": {3: 8, 9: 20}, "Malachi": {3: 18, 4: 6}}, kjvVersification},
+		{"hebrew malachi", map[string]map[int]int{"Malachi": {3: 24}}, mtVersification},
+		{"hebrew psalm titles", map[string]map[int]int{"Psalms": {3: 9}}, mtVersification},
+		{"greek psalms", map[string]map[int]int{"Psalm": {9: 39}, "Romans": {14: 23, 16: 27}}, vulgateVersification},
+		{"septuagint", map[string]map[int]int{"Psalm": {9: 39}, "Romans": {14: 26, 16: 24}}, lxxVersification},
+		{"no telling chapters", map[string]map[int]int{"John": {3: 36}}, kjvVersification},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := versificationTestBible(tt.chapters).detectVersification(); got != tt.want {
+				t.Errorf("detectVersification() = %s, want %s", got.name, tt.want.name)
+			}
+		})
+	}
+}
+
+func TestMapVerseConfigured(t *testing.T) {
+	mbd := &MultiBibleData{
+		translations:   map[string]*BibleData{"BHS": versificationTestBible(map[string]map[int]int{"Malachi": {3: 24}})},
+		versifications: make(map[string]*versification),
+	}
+	if err := mbd.SetVersifications(map[string]string{"LXX": "lxx"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mbd.SetVersifications(map[string]string{"KJV": "nrsv"}); err == nil {
+		t.Error("SetVersifications accepted an unknown scheme")
+	}
+
+	tests := []struct {
+		ref      verseKey
+		from, to string
+		want     verseKey
+	}{
+		{verseKey{"Malachi", 4, 1}, "KJV", "BHS", verseKey{"Malachi", 3, 19}},
+		{verseKey{"Malachi", 3, 19}, "BHS", "LXX", verseKey{"Malachi", 4, 1}},
+		{verseKey{"Psalm", 51, 3}, "BHS", "LXX", verseKey{"Psalm", 50, 3}},
+		{verseKey{"John", 3, 16}, "KJV", "LXX", verseKey{"John", 3, 16}},
+	}
+	for _, tt := range tests {
+		if got := mbd.MapVerse(tt.ref, tt.from, tt.to); got != tt.want {
+			t.Errorf("MapVerse(%v, %s, %s) = %v, want %v", tt.ref, tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestCanonicalKeyTitles(t *testing.T) {
+	mbd := &MultiBibleData{
+		translations:   map[string]*BibleData{},
+		versifications: make(map[string]*versification),
+	}
+	if err := mbd.SetVersifications(map[string]string{"BHS": "mt", "LXX": "lxx"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		translation string
+		verse       Verse
+		want        verseKey
+	}{
+		{"KJV", Verse{Book: "Psalm", Chapter: 51, Verse: 1}, verseKey{"Psalm", 51, 1}},
+		{"BHS", Verse{Book: "Psalm", Chapter: 51, Verse: 1}, verseKey{"Psalm", 51, -1}},
+		{"BHS", Verse{Book: "Psalm", Chapter: 51, Verse: 2}, verseKey{"Psalm", 51, 0}},
+		{"BHS", Verse{Book: "Psalm", Chapter: 51, Verse: 3}, verseKey{"Psalm", 51, 1}},
+		{"BHS", Verse{Book: "Psalms", Chapter: 3, Verse: 1}, verseKey{"Psalm", 3, 0}},
+		{"BHS", Verse{Book: "Psalm", Chapter: 3, Verse: 2}, verseKey{"Psalm", 3, 1}},
+		{"BHS", Verse{Book: "Malachi", Chapter: 3, Verse: 19}, verseKey{"Malachi", 4, 1}},
+		{"LXX", Verse{Book: "Psalm", Chapter: 9, Verse: 1}, verseKey{"Psalm", 9, 0}},
+		{"LXX", Verse{Book: "Psalm", Chapter: 50, Verse: 3}, verseKey{"Psalm", 51, 1}},
+	}
+	for _, tt := range tests {
+		if got := mbd.canonicalKey(tt.translation, tt.verse); got != tt.want {
+			t.Errorf("canonicalKey(%s, %v) = %v, want %v", tt.translation, tt.verse, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import "fmt"
+
+// Visual mode extends the selection from an anchor verse to the selected
+// verse, like vim's visual line mode. Range actions such as highlighting,
+// bookmarking and notes act on every selected verse and then leave
+// visual mode. Changing chapter cancels the selection.
+
+func (m *model) toggleVisual() {
+	if m.visual || len(m.verses) == 0 {
+		m.visual = false
+		return
+	}
+	m.visual = true
+	m.visualAnchor = m.selected
+}
+
+// visualRange returns the first and last index of the selected verses.
+func (m model) visualRange() (int, int) {
+	if !m.visual {
+		return m.selected, m.selected
+	}
+	return min(m.visualAnchor, m.selected), max(m.visualAnchor, m.selected)
+}
+
+func (m model) isSelected(i int) bool {
+	start, end := m.visualRange()
+	return i >= start && i <= end
+}
+
+// selectedVerses returns the verses an action applies to: the visual
+// selection, or the selected verse outside visual mode.
+func (m model) selectedVerses() []Verse {
+	start, end := m.visualRange()
+	start, end = max(0, start), min(len(m.verses)-1, end)
+	if start > end {
+		return nil
+	}
+	return m.verses[start : end+1]
+}
+
+// formatPassage formats consecutive verses of one chapter as a reference:
+// "Romans 8:28" or "Romans 8:28-39".
+func formatPassage(verses []Verse) string {
+	if len(verses) == 0 {
+		return ""
+	}
+	first, last := verses[0], verses[len(verses)-1]
+	if len(verses) == 1 {
+		return fmt.Sprintf("%s %d:%d", first.Book, first.Chapter, first.Verse)
+	}
+	return fmt.Sprintf("%s %d:%d-%d", first.Book, first.Chapter, first.Verse, last.Verse)
+}
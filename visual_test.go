@@ -0,0 +1,42 @@
+package main
+
+import "testing"
+
+func TestVisualRange(t *testing.T) {
+	tests := []struct {
+		name          string
+		visual        bool
+		anchor        int
+		selected      int
+		noVerses      bool
+		wantStart     int
+		wantEnd       int
+		wantPassage   string
+		wantNumVerses int
+	}{
+		{name: "not visual", anchor: 0, selected: 2, wantStart: 2, wantEnd: 2, wantPassage: "Genesis 1:3", wantNumVerses: 1},
+		{name: "just started", visual: true, anchor: 1, selected: 1, wantStart: 1, wantEnd: 1, wantPassage: "Genesis 1:2", wantNumVerses: 1},
+		{name: "down from the anchor", visual: true, anchor: 1, selected: 3, wantStart: 1, wantEnd: 3, wantPassage: "Genesis 1:2-5", wantNumVerses: 3},
+		{name: "up from the anchor", visual: true, anchor: 2, selected: 0, wantStart: 0, wantEnd: 2, wantPassage: "Genesis 1:1-3", wantNumVerses: 3},
+		{name: "anchor past the chapter", visual: true, anchor: 9, selected: 2, wantStart: 2, wantEnd: 9, wantPassage: "Genesis 1:3-5", wantNumVerses: 2},
+		{name: "empty chapter", noVerses: true, wantStart: 0, wantEnd: 0, wantPassage: "", wantNumVerses: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel(t)
+			if tt.noVerses {
+				m.verses = nil
+			}
+			m.visual = tt.visual
+			m.visualAnchor = tt.anchor
+			m.selected = tt.selected
+			if start, end := m.visualRange(); start != tt.wantStart || end != tt.wantEnd {
+				t.Errorf("visualRange() = %d, %d, want %d, %d", start, end, tt.wantStart, tt.wantEnd)
+			}
+			verses := m.selectedVerses()
+			if len(verses) != tt.wantNumVerses || formatPassage(verses) != tt.wantPassage {
+				t.Errorf("selectedVerses() = %q (%d verses), want %q (%d verses)", formatPassage(verses), len(verses), tt.wantPassage, tt.wantNumVerses)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// NewBibleDataFromZefania parses a Zefania XML document
+// (<XMLBIBLE>/<BIBLEBOOK bnumber>/<CHAPTER cnumber>/<VERS vnumber>).
+// Books are named from their number in the standard 66-book order, or
+// from bname when the number is outside it. Notes are dropped.
+func NewBibleDataFromZefania(xmlData []byte) (*BibleData, error) {
+	bible := make(Bible)
+	decoder := xml.NewDecoder(bytes.NewReader(xmlData))
+	decoder.Strict = false
+
+	var (
+		book      string
+		chapter   string
+		verse     string
+		text      strings.Builder
+		noteDepth int
+	)
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			line, column := decoder.InputPos()
+			return nil, &SyntaxError{Format: "Zefania XML", Line: line, Column: column, Err: err}
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch strings.ToUpper(t.Name.Local) {
+			case "BIBLEBOOK":
+				book = zefaniaBookName(osisAttr(t, "bnumber"), osisAttr(t, "bname"))
+				chapter = ""
+				if book != "" && bible[book] == nil {
+					bible[book] = make(map[string]map[string]string)
+				}
+			case "CHAPTER":
+				chapter = osisAttr(t, "cnumber")
+				if book != "" && bible[book][chapter] == nil {
+					bible[book][chapter] = make(map[string]string)
+				}
+			case "VERS":
+				if chapter == "" {
+					line, column := decoder.InputPos()
+					return nil, &SyntaxError{Format: "Zefania XML", Line: line, Column: column, Err: errors.New("VERS outside a CHAPTER")}
+				}
+				verse = osisAttr(t, "vnumber")
+				text.Reset()
+			case "NOTE":
+				noteDepth++
+			case "BR":
+				text.WriteByte(' ')
+			}
+		case xml.EndElement:
+			switch strings.ToUpper(t.Name.Local) {
+			case "BIBLEBOOK":
+				book, chapter = "", ""
+			case "CHAPTER":
+				chapter = ""
+			case "VERS":
+				if book != "" && verse != "" {
+					bible[book][chapter][verse] = strings.Join(strings.Fields(text.String()), " ")
+				}
+				verse = ""
+			case "NOTE":
+				noteDepth = max(0, noteDepth-1)
+			}
+		case xml.CharData:
+			if verse != "" && noteDepth == 0 {
+				text.Write(t)
+			}
+		}
+	}
+
+	if len(bible) == 0 {
+		return nil, fmt.Errorf("failed to parse Zefania XML: no books found")
+	}
+
+	return newBibleDataFromBible(bible), nil
+}
+
+func zefaniaBookName(number, name string) string {
+	if n, err := strconv.Atoi(number); err == nil && n >= 1 && n <= len(biblicalOrder) {
+		return biblicalOrder[n-1]
+	}
+	return importedBookName(name)
+}
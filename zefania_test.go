@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNewBibleDataFromZefania(t *testing.T) {
+	tests := []struct {
+		name string
+		xml  string
+		want []Verse
+	}{
+		{
+			name: "books by number",
+			xml: `<XMLBIBLE>
+				<BIBLEBOOK bnumber="1"><CHAPTER cnumber="1">
+					<VERS vnumber="1">In the beginning</VERS>
+					<VERS vnumber="2">And the earth</VERS>
+				</CHAPTER></BIBLEBOOK>
+				<BIBLEBOOK bnumber="43"><CHAPTER cnumber="3"><VERS vnumber="16">For God so loved</VERS></CHAPTER></BIBLEBOOK>
+			</XMLBIBLE>`,
+			want: []Verse{
+				{Book: "Genesis", Chapter: 1, Verse: 1, Text: "In the beginning"},
+				{Book: "Genesis", Chapter: 1, Verse: 2, Text: "And the earth"},
+				{Book: "John", Chapter: 3, Verse: 16, Text: "For God so loved"},
+			},
+		},
+		{
+			name: "book by name outside the standard order",
+			xml:  `<XMLBIBLE><BIBLEBOOK bnumber="70" bname="Tobit"><CHAPTER cnumber="1"><VERS vnumber="1">Tobit text</VERS></CHAPTER></BIBLEBOOK></XMLBIBLE>`,
+			want: []Verse{{Book: "Tobit", Chapter: 1, Verse: 1, Text: "Tobit text"}},
+		},
+		{
+			name: "notes dropped and breaks become spaces",
+			xml:  `<XMLBIBLE><BIBLEBOOK bnumber="19"><CHAPTER cnumber="23"><VERS vnumber="1">The LORD<NOTE>Hebrew YHWH</NOTE> is my<BR/>shepherd</VERS></CHAPTER></BIBLEBOOK></XMLBIBLE>`,
+			want: []Verse{{Book: "Psalm", Chapter: 23, Verse: 1, Text: "The LORD is my shepherd"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bd, err := NewBibleDataFromZefania([]byte(tt.xml))
+			if err != nil {
+				t.Fatalf("NewBibleDataFromZefania: %v", err)
+			}
+			if !reflect.DeepEqual(bd.verses, tt.want) {
+				t.Errorf("verses = %v, want %v", bd.verses, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewBibleDataFromZefaniaErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		xml  string
+	}{
+		{
+			name: "verse before the first chapter of a later book",
+			xml: `<XMLBIBLE>
+				<BIBLEBOOK bnumber="1"><CHAPTER cnumber="1"><VERS vnumber="1">Genesis</VERS></CHAPTER></BIBLEBOOK>
+				<BIBLEBOOK bnumber="2"><VERS vnumber="1">Exodus</VERS></BIBLEBOOK>
+			</XMLBIBLE>`,
+		},
+		{
+			name: "verse after a chapter ended",
+			xml:  `<XMLBIBLE><BIBLEBOOK bnumber="1"><CHAPTER cnumber="1"></CHAPTER><VERS vnumber="1">Genesis</VERS></BIBLEBOOK></XMLBIBLE>`,
+		},
+		{
+			name: "malformed XML",
+			xml:  `<XMLBIBLE><BIBLEBOOK bnumber="1"><CHAPTER cnumber="1"><VERS vnumber="1">Genesis</CHAPTER>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBibleDataFromZefania([]byte(tt.xml))
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Errorf("error = %v, want a SyntaxError", err)
+			}
+		})
+	}
+}